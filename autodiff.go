@@ -0,0 +1,316 @@
+package numericalanalysis
+
+import "math"
+
+// autodiff.go
+// Automatic differentiation: forward mode with dual numbers and reverse mode with a tape
+
+// Scalar is a number type that records derivatives. Write a function once over Scalar
+// and instantiate it with Dual (forward mode) or Var (reverse mode):
+//
+//	func rosenbrock[T Scalar[T]](x []T) T {
+//		a := x[0].Const(1).Sub(x[0])
+//		b := x[1].Sub(x[0].Mul(x[0]))
+//		return a.Mul(a).Add(b.Mul(b).Scale(100))
+//	}
+//
+//	grad := DualGradient(rosenbrock[Dual])
+type Scalar[T any] interface {
+	Value() float64
+	Const(c float64) T // Constant of the same kind as the receiver
+	Add(y T) T
+	Sub(y T) T
+	Mul(y T) T
+	Div(y T) T
+	Neg() T
+	AddConst(c float64) T
+	Scale(c float64) T
+	Pow(p float64) T
+	Sqrt() T
+	Exp() T
+	Log() T
+	Sin() T
+	Cos() T
+	Abs() T
+}
+
+// Dual is a hyper-dual number a + b·ε1 + c·ε2 + d·ε1ε2 with ε1² = ε2² = 0.
+// Seeding ε1 and ε2 with directions i and j gives ∂f/∂x_i in E1 and ∂²f/∂x_i∂x_j in E12.
+type Dual struct {
+	Re  float64 // Value
+	E1  float64 // First derivative along the first direction
+	E2  float64 // First derivative along the second direction
+	E12 float64 // Mixed second derivative
+}
+
+// chain applies a function with value f0 and derivatives f1, f2 at x.Re
+func (x Dual) chain(f0, f1, f2 float64) Dual {
+	return Dual{
+		Re:  f0,
+		E1:  f1 * x.E1,
+		E2:  f1 * x.E2,
+		E12: f1*x.E12 + f2*x.E1*x.E2,
+	}
+}
+
+func (x Dual) Value() float64          { return x.Re }
+func (x Dual) Const(c float64) Dual    { return Dual{Re: c} }
+func (x Dual) Neg() Dual               { return Dual{-x.Re, -x.E1, -x.E2, -x.E12} }
+func (x Dual) AddConst(c float64) Dual { return Dual{x.Re + c, x.E1, x.E2, x.E12} }
+func (x Dual) Scale(c float64) Dual    { return Dual{c * x.Re, c * x.E1, c * x.E2, c * x.E12} }
+
+func (x Dual) Add(y Dual) Dual {
+	return Dual{x.Re + y.Re, x.E1 + y.E1, x.E2 + y.E2, x.E12 + y.E12}
+}
+
+func (x Dual) Sub(y Dual) Dual {
+	return Dual{x.Re - y.Re, x.E1 - y.E1, x.E2 - y.E2, x.E12 - y.E12}
+}
+
+func (x Dual) Mul(y Dual) Dual {
+	return Dual{
+		Re:  x.Re * y.Re,
+		E1:  x.Re*y.E1 + x.E1*y.Re,
+		E2:  x.Re*y.E2 + x.E2*y.Re,
+		E12: x.Re*y.E12 + x.E1*y.E2 + x.E2*y.E1 + x.E12*y.Re,
+	}
+}
+
+func (x Dual) Div(y Dual) Dual {
+	inv := 1 / y.Re
+	return x.Mul(y.chain(inv, -inv*inv, 2*inv*inv*inv))
+}
+
+func (x Dual) Pow(p float64) Dual {
+	// Vanishing derivatives of x^0 and x^1 stay zero where the power of x in them is infinite
+	d1, d2 := 0., 0.
+	if p != 0 {
+		d1 = p * math.Pow(x.Re, p-1)
+	}
+	if p*(p-1) != 0 {
+		d2 = p * (p - 1) * math.Pow(x.Re, p-2)
+	}
+	return x.chain(math.Pow(x.Re, p), d1, d2)
+}
+
+func (x Dual) Sqrt() Dual {
+	s := math.Sqrt(x.Re)
+	return x.chain(s, 0.5/s, -0.25/(s*x.Re))
+}
+
+func (x Dual) Exp() Dual {
+	e := math.Exp(x.Re)
+	return x.chain(e, e, e)
+}
+
+func (x Dual) Log() Dual {
+	return x.chain(math.Log(x.Re), 1/x.Re, -1/(x.Re*x.Re))
+}
+
+func (x Dual) Sin() Dual {
+	s, c := math.Sincos(x.Re)
+	return x.chain(s, c, -s)
+}
+
+func (x Dual) Cos() Dual {
+	s, c := math.Sincos(x.Re)
+	return x.chain(c, -s, -c)
+}
+
+func (x Dual) Abs() Dual {
+	if x.Re < 0 {
+		return x.Neg()
+	}
+	return x
+}
+
+// DualGradient returns the exact gradient of f computed with forward-mode differentiation (n passes)
+func DualGradient(f func(x []Dual) Dual) func(x []float64) []float64 {
+	return func(x []float64) []float64 {
+		d := make([]Dual, len(x))
+		for i := range x {
+			d[i] = Dual{Re: x[i]}
+		}
+
+		grad := make([]float64, len(x))
+		for i := range x {
+			d[i].E1 = 1
+			grad[i] = f(d).E1
+			d[i].E1 = 0
+		}
+		return grad
+	}
+}
+
+// DualHessian returns the exact Hessian matrix of f computed with hyper-dual numbers (n(n+1)/2 passes)
+func DualHessian(f func(x []Dual) Dual) func(x []float64) Matrix {
+	return func(x []float64) Matrix {
+		n := len(x)
+		d := make([]Dual, n)
+		for i := range x {
+			d[i] = Dual{Re: x[i]}
+		}
+
+		H := make(Matrix, n)
+		for i := range n {
+			H[i] = make([]float64, n)
+		}
+		for i := range n {
+			d[i].E1 = 1
+			for j := i; j < n; j++ {
+				d[j].E2 = 1
+				H[i][j] = f(d).E12
+				H[j][i] = H[i][j]
+				d[j].E2 = 0
+			}
+			d[i].E1 = 0
+		}
+		return H
+	}
+}
+
+// DualJacobian returns the exact Jacobian matrix J[i][j] = ∂f_i/∂u_j of a system of functions
+func DualJacobian(f []func(u []Dual) Dual) func(u []float64) Matrix {
+	return func(u []float64) Matrix {
+		d := make([]Dual, len(u))
+		for j := range u {
+			d[j] = Dual{Re: u[j]}
+		}
+
+		J := make(Matrix, len(f))
+		for i := range f {
+			J[i] = make([]float64, len(u))
+		}
+		for j := range u {
+			d[j].E1 = 1
+			for i := range f {
+				J[i][j] = f[i](d).E1
+			}
+			d[j].E1 = 0
+		}
+		return J
+	}
+}
+
+// Tape records operations on Var values for reverse-mode differentiation
+type Tape struct {
+	nodes []tapeNode
+}
+
+// tapeNode stores up to two parents of an operation and the local partial derivatives
+type tapeNode struct {
+	parents  [2]int // -1 if absent
+	partials [2]float64
+}
+
+// Var is a value recorded on a Tape
+type Var struct {
+	tape  *Tape
+	index int
+	value float64
+}
+
+// Variable records an independent variable on the tape
+func (t *Tape) Variable(x float64) Var {
+	return t.push(x, -1, 0, -1, 0)
+}
+
+func (t *Tape) push(value float64, p0 int, d0 float64, p1 int, d1 float64) Var {
+	t.nodes = append(t.nodes, tapeNode{parents: [2]int{p0, p1}, partials: [2]float64{d0, d1}})
+	return Var{tape: t, index: len(t.nodes) - 1, value: value}
+}
+
+// Gradient returns ∂y/∂v for every value v recorded on the tape, in recording order
+func (t *Tape) Gradient(y Var) []float64 {
+	adj := make([]float64, len(t.nodes))
+	adj[y.index] = 1
+	for i := y.index; i >= 0; i-- {
+		for k, p := range t.nodes[i].parents {
+			if p >= 0 {
+				adj[p] += adj[i] * t.nodes[i].partials[k]
+			}
+		}
+	}
+	return adj
+}
+
+// unary records an operation with value f0 and derivative f1
+func (x Var) unary(f0, f1 float64) Var {
+	return x.tape.push(f0, x.index, f1, -1, 0)
+}
+
+func (x Var) Value() float64         { return x.value }
+func (x Var) Const(c float64) Var    { return x.tape.Variable(c) }
+func (x Var) Neg() Var               { return x.unary(-x.value, -1) }
+func (x Var) AddConst(c float64) Var { return x.unary(x.value+c, 1) }
+func (x Var) Scale(c float64) Var    { return x.unary(c*x.value, c) }
+
+func (x Var) Add(y Var) Var {
+	return x.tape.push(x.value+y.value, x.index, 1, y.index, 1)
+}
+
+func (x Var) Sub(y Var) Var {
+	return x.tape.push(x.value-y.value, x.index, 1, y.index, -1)
+}
+
+func (x Var) Mul(y Var) Var {
+	return x.tape.push(x.value*y.value, x.index, y.value, y.index, x.value)
+}
+
+func (x Var) Div(y Var) Var {
+	return x.tape.push(x.value/y.value, x.index, 1/y.value, y.index, -x.value/(y.value*y.value))
+}
+
+func (x Var) Pow(p float64) Var {
+	// The vanishing derivative of x^0 stays zero at x = 0
+	d := 0.
+	if p != 0 {
+		d = p * math.Pow(x.value, p-1)
+	}
+	return x.unary(math.Pow(x.value, p), d)
+}
+
+func (x Var) Sqrt() Var {
+	s := math.Sqrt(x.value)
+	return x.unary(s, 0.5/s)
+}
+
+func (x Var) Exp() Var {
+	e := math.Exp(x.value)
+	return x.unary(e, e)
+}
+
+func (x Var) Log() Var {
+	return x.unary(math.Log(x.value), 1/x.value)
+}
+
+func (x Var) Sin() Var {
+	return x.unary(math.Sin(x.value), math.Cos(x.value))
+}
+
+func (x Var) Cos() Var {
+	return x.unary(math.Cos(x.value), -math.Sin(x.value))
+}
+
+func (x Var) Abs() Var {
+	if x.value < 0 {
+		return x.Neg()
+	}
+	return x
+}
+
+// TapeGradient returns the exact gradient of f computed with reverse-mode differentiation (one pass)
+func TapeGradient(f func(x []Var) Var) func(x []float64) []float64 {
+	return func(x []float64) []float64 {
+		t := &Tape{}
+		v := make([]Var, len(x))
+		for i := range x {
+			v[i] = t.Variable(x[i])
+		}
+
+		adj := t.Gradient(f(v))
+		grad := make([]float64, len(x))
+		copy(grad, adj[:len(x)])
+		return grad
+	}
+}
@@ -0,0 +1,198 @@
+package numericalanalysis_test
+
+import (
+	"math"
+	"testing"
+
+	numericalanalysis "github.com/Russia9/numerical-analysis"
+)
+
+// rosenbrockAD is the Rosenbrock function written once for every Scalar type
+func rosenbrockAD[T numericalanalysis.Scalar[T]](x []T) T {
+	a := x[0].Const(1).Sub(x[0])
+	b := x[1].Sub(x[0].Mul(x[0]))
+	return a.Mul(a).Add(b.Mul(b).Scale(100))
+}
+
+// elementaryAD uses every elementary function: f(x,y) = sin(x)·exp(y) + log(x)/sqrt(y) + |x - y|^1.5 - (1 - cos(xy))
+func elementaryAD[T numericalanalysis.Scalar[T]](x []T) T {
+	res := x[0].Sin().Mul(x[1].Exp())
+	res = res.Add(x[0].Log().Div(x[1].Sqrt()))
+	res = res.Add(x[0].Sub(x[1]).Abs().Pow(1.5))
+	return res.Add(x[0].Mul(x[1]).Cos().Neg().AddConst(1).Neg())
+}
+
+func elementaryGrad(x []float64) []float64 {
+	d := x[0] - x[1]
+	s := math.Copysign(1.5*math.Sqrt(math.Abs(d)), d)
+	return []float64{
+		math.Cos(x[0])*math.Exp(x[1]) + 1/(x[0]*math.Sqrt(x[1])) + s - x[1]*math.Sin(x[0]*x[1]),
+		math.Sin(x[0])*math.Exp(x[1]) - 0.5*math.Log(x[0])*math.Pow(x[1], -1.5) - s - x[0]*math.Sin(x[0]*x[1]),
+	}
+}
+
+func TestDualGradient(t *testing.T) {
+	x := []float64{1.3, 0.4}
+
+	t.Run("rosenbrock function", func(t *testing.T) {
+		grad := numericalanalysis.DualGradient(rosenbrockAD[numericalanalysis.Dual])(x)
+		assertSlice(t, "grad", grad, rosenbrockGrad(x), 1e-12)
+	})
+
+	t.Run("elementary functions", func(t *testing.T) {
+		grad := numericalanalysis.DualGradient(elementaryAD[numericalanalysis.Dual])(x)
+		assertSlice(t, "grad", grad, elementaryGrad(x), 1e-12)
+	})
+}
+
+func TestDualHessian(t *testing.T) {
+	t.Run("rosenbrock function", func(t *testing.T) {
+		x := []float64{1.3, 0.4}
+		H := numericalanalysis.DualHessian(rosenbrockAD[numericalanalysis.Dual])(x)
+
+		expected := numericalanalysis.Matrix{
+			{2 - 400*(x[1]-3*x[0]*x[0]), -400 * x[0]},
+			{-400 * x[0], 200},
+		}
+		for i := range expected {
+			assertSlice(t, "H", H[i], expected[i], 1e-10)
+		}
+	})
+
+	t.Run("linear and constant powers at the origin", func(t *testing.T) {
+		f := func(x []numericalanalysis.Dual) numericalanalysis.Dual { return x[0].Pow(1).Add(x[1].Pow(0)) }
+		H := numericalanalysis.DualHessian(f)([]float64{0, 0})
+		for i := range H {
+			assertSlice(t, "H", H[i], []float64{0, 0}, 0)
+		}
+		grad := numericalanalysis.DualGradient(f)([]float64{0, 0})
+		assertSlice(t, "grad", grad, []float64{1, 0}, 0)
+	})
+
+	t.Run("elementary functions against differentiated gradient", func(t *testing.T) {
+		x := []float64{1.3, 0.4}
+		H := numericalanalysis.DualHessian(elementaryAD[numericalanalysis.Dual])(x)
+
+		h := 1e-6
+		for j := range x {
+			xp := []float64{x[0], x[1]}
+			xm := []float64{x[0], x[1]}
+			xp[j] += h
+			xm[j] -= h
+			gp, gm := elementaryGrad(xp), elementaryGrad(xm)
+			for i := range x {
+				if expected := (gp[i] - gm[i]) / (2 * h); math.Abs(H[i][j]-expected) > 1e-6 {
+					t.Errorf("H[%d][%d] = %v, want ~%v", i, j, H[i][j], expected)
+				}
+			}
+		}
+	})
+}
+
+func TestDualJacobian(t *testing.T) {
+	f := []func(u []numericalanalysis.Dual) numericalanalysis.Dual{
+		func(u []numericalanalysis.Dual) numericalanalysis.Dual {
+			return u[0].Mul(u[0]).Add(u[1].Mul(u[1])).AddConst(-4)
+		},
+		func(u []numericalanalysis.Dual) numericalanalysis.Dual { return u[0].Mul(u[1]).Sin() },
+	}
+	u := []float64{1, 2}
+
+	J := numericalanalysis.DualJacobian(f)(u)
+	expected := numericalanalysis.Matrix{
+		{2, 4},
+		{2 * math.Cos(2), math.Cos(2)},
+	}
+	for i := range expected {
+		assertSlice(t, "J", J[i], expected[i], 1e-12)
+	}
+}
+
+func TestTapeGradient(t *testing.T) {
+	x := []float64{1.3, 0.4}
+
+	t.Run("rosenbrock function", func(t *testing.T) {
+		grad := numericalanalysis.TapeGradient(rosenbrockAD[numericalanalysis.Var])(x)
+		assertSlice(t, "grad", grad, rosenbrockGrad(x), 1e-12)
+	})
+
+	t.Run("elementary functions", func(t *testing.T) {
+		grad := numericalanalysis.TapeGradient(elementaryAD[numericalanalysis.Var])(x)
+		assertSlice(t, "grad", grad, elementaryGrad(x), 1e-12)
+	})
+
+	t.Run("linear and constant powers at the origin", func(t *testing.T) {
+		f := func(x []numericalanalysis.Var) numericalanalysis.Var { return x[0].Pow(1).Add(x[1].Pow(0)) }
+		grad := numericalanalysis.TapeGradient(f)([]float64{0, 0})
+		assertSlice(t, "grad", grad, []float64{1, 0}, 0)
+	})
+
+	t.Run("shared subexpressions", func(t *testing.T) {
+		// f(x) = (x·x)·(x·x) = x^4, f'(x) = 4x^3
+		tape := &numericalanalysis.Tape{}
+		v := tape.Variable(2)
+		sq := v.Mul(v)
+		y := sq.Mul(sq)
+
+		grad := tape.Gradient(y)
+		if y.Value() != 16 || grad[0] != 32 {
+			t.Errorf("f = %v, f' = %v, want 16, 32", y.Value(), grad[0])
+		}
+	})
+}
+
+func TestNewtonWithExactDerivatives(t *testing.T) {
+	t.Run("DampedNewtonExtremum - rosenbrock function", func(t *testing.T) {
+		opts := numericalanalysis.NewtonOptions{
+			Gradient: numericalanalysis.DualGradient(rosenbrockAD[numericalanalysis.Dual]),
+			Hessian:  numericalanalysis.DualHessian(rosenbrockAD[numericalanalysis.Dual]),
+		}
+
+		result, err := numericalanalysis.DampedNewtonExtremumWithOptions(rosenbrock, []float64{-1.2, 1}, nil, 1, 0.5, 1e-10, 64, opts)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		assertSlice(t, "X", result.X, []float64{1, 1}, 1e-9)
+	})
+
+	t.Run("DampedNewtonExtremum - missing steps for finite differences", func(t *testing.T) {
+		opts := numericalanalysis.NewtonOptions{
+			Gradient: numericalanalysis.DualGradient(rosenbrockAD[numericalanalysis.Dual]),
+		}
+
+		_, err := numericalanalysis.DampedNewtonExtremumWithOptions(rosenbrock, []float64{-1.2, 1}, nil, 1, 0.5, 1e-10, 64, opts)
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+
+	t.Run("SENewton - circle and line", func(t *testing.T) {
+		f := []func(u []float64) float64{
+			func(u []float64) float64 { return u[0]*u[0] + u[1]*u[1] - 4 },
+			func(u []float64) float64 { return u[0] - u[1] },
+		}
+		fd := []func(u []numericalanalysis.Dual) numericalanalysis.Dual{
+			func(u []numericalanalysis.Dual) numericalanalysis.Dual {
+				return u[0].Mul(u[0]).Add(u[1].Mul(u[1])).AddConst(-4)
+			},
+			func(u []numericalanalysis.Dual) numericalanalysis.Dual { return u[0].Sub(u[1]) },
+		}
+		opts := numericalanalysis.NewtonOptions{Jacobian: numericalanalysis.DualJacobian(fd)}
+
+		result, err := numericalanalysis.SENewtonWithOptions(f, []float64{1, 2}, nil, 1e-12, opts)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		assertSlice(t, "X", result.X, []float64{math.Sqrt2, math.Sqrt2}, 1e-12)
+	})
+
+	t.Run("BFGSExtremum - reverse-mode gradient", func(t *testing.T) {
+		grad := numericalanalysis.TapeGradient(rosenbrockAD[numericalanalysis.Var])
+
+		result, err := numericalanalysis.BFGSExtremum(rosenbrock, grad, []float64{-1.2, 1}, nil, 1e-10, 1000)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		assertSlice(t, "result", result, []float64{1, 1}, 1e-8)
+	})
+}
@@ -0,0 +1,337 @@
+package numericalanalysis
+
+import (
+	"math"
+	"sort"
+)
+
+// bicubic.go
+// Smooth interpolation on rectilinear grids: bicubic convolution, bicubic Hermite and tensor-product cubic splines
+
+// Grid2D is a rectilinear grid of values Z[i][j] = f(X[i], Y[j])
+type Grid2D struct {
+	X, Y []float64   // Strictly increasing coordinates, at least two of each
+	Z    [][]float64 // len(X) rows of len(Y) values
+}
+
+// Surface is an interpolant of two-dimensional data together with its partial derivatives.
+// Outside the grid every interpolant continues the polynomial of the nearest cell.
+type Surface struct {
+	Value Func2D // f(x, y)
+	DX    Func2D // ∂f/∂x
+	DY    Func2D // ∂f/∂y
+}
+
+// GridFromPoints arranges points into a rectilinear grid. Every combination of the distinct X and Y
+// of the points must occur exactly once, as BilinearInterpolation2D expects.
+func GridFromPoints(points []Point3D) (Grid2D, error) {
+	// Distinct coordinates
+	xIndex, yIndex := map[float64]int{}, map[float64]int{}
+	var grid Grid2D
+	for _, p := range points {
+		if _, ok := xIndex[p.X]; !ok {
+			xIndex[p.X] = 0
+			grid.X = append(grid.X, p.X)
+		}
+		if _, ok := yIndex[p.Y]; !ok {
+			yIndex[p.Y] = 0
+			grid.Y = append(grid.Y, p.Y)
+		}
+	}
+	sort.Float64s(grid.X)
+	sort.Float64s(grid.Y)
+	for i, x := range grid.X {
+		xIndex[x] = i
+	}
+	for j, y := range grid.Y {
+		yIndex[y] = j
+	}
+
+	// Check input
+	if len(grid.X) < 2 || len(grid.Y) < 2 || len(points) != len(grid.X)*len(grid.Y) {
+		return Grid2D{}, ErrWrongInput
+	}
+
+	grid.Z = make([][]float64, len(grid.X))
+	seen := make([][]bool, len(grid.X))
+	for i := range grid.Z {
+		grid.Z[i] = make([]float64, len(grid.Y))
+		seen[i] = make([]bool, len(grid.Y))
+	}
+	for _, p := range points {
+		i, j := xIndex[p.X], yIndex[p.Y]
+		if seen[i][j] {
+			return Grid2D{}, ErrWrongInput
+		}
+		seen[i][j] = true
+		grid.Z[i][j] = p.Z
+	}
+
+	return grid, nil
+}
+
+// BicubicConvolution builds Keys' cubic convolution interpolant (a = -1/2), which is C¹ and third-order accurate.
+// Both axes of the grid must be equally spaced.
+func BicubicConvolution(grid Grid2D) (Surface, error) {
+	// Check input
+	if err := checkGrid2D(grid); err != nil {
+		return Surface{}, err
+	}
+	if !equallySpaced(grid.X) || !equallySpaced(grid.Y) {
+		return Surface{}, ErrWrongInput
+	}
+
+	// Values padded with one ghost point on every side, from Keys' boundary condition
+	n, m := len(grid.X), len(grid.Y)
+	P := make([][]float64, n+2)
+	for i := range P {
+		P[i] = make([]float64, m+2)
+		if i > 0 && i <= n {
+			copy(P[i][1:], grid.Z[i-1])
+		}
+	}
+	for j := 1; j <= m; j++ {
+		P[0][j] = keysGhost(P[1][j], P[2][j], P[min(3, n)][j], n)
+		P[n+1][j] = keysGhost(P[n][j], P[n-1][j], P[max(n-2, 1)][j], n)
+	}
+	for i := range P {
+		P[i][0] = keysGhost(P[i][1], P[i][2], P[i][min(3, m)], m)
+		P[i][m+1] = keysGhost(P[i][m], P[i][m-1], P[i][max(m-2, 1)], m)
+	}
+
+	hx, hy := grid.X[1]-grid.X[0], grid.Y[1]-grid.Y[0]
+	cx, cy := &intervalCache{x: grid.X}, &intervalCache{x: grid.Y}
+	eval := func(x, y float64, dx, dy bool) float64 {
+		i, j := cx.find(x), cy.find(y)
+		wx := keysWeights((x-grid.X[i])/hx, dx)
+		wy := keysWeights((y-grid.Y[j])/hy, dy)
+		res := 0.
+		for k := range 4 {
+			for l := range 4 {
+				res += wx[k] * wy[l] * P[i+k][j+l]
+			}
+		}
+		if dx {
+			res /= hx
+		}
+		if dy {
+			res /= hy
+		}
+		return res
+	}
+
+	return surface(eval), nil
+}
+
+// BicubicHermite builds the C¹ bicubic Hermite interpolant with the derivatives f_x, f_y and f_xy at the grid points
+// estimated by three-point finite differences, so it reproduces quadratics in x and y exactly
+func BicubicHermite(grid Grid2D) (Surface, error) {
+	// Check input
+	if err := checkGrid2D(grid); err != nil {
+		return Surface{}, err
+	}
+
+	// Derivative estimates along the columns and the rows
+	n, m := len(grid.X), len(grid.Y)
+	fx, fy, fxy := make([][]float64, n), make([][]float64, n), make([][]float64, n)
+	for i := range n {
+		fy[i] = gridDerivatives(grid.Y, grid.Z[i])
+		fx[i] = make([]float64, m)
+		fxy[i] = make([]float64, m)
+	}
+	column, dyColumn := make([]float64, n), make([]float64, n)
+	for j := range m {
+		for i := range n {
+			column[i], dyColumn[i] = grid.Z[i][j], fy[i][j]
+		}
+		d, dd := gridDerivatives(grid.X, column), gridDerivatives(grid.X, dyColumn)
+		for i := range n {
+			fx[i][j], fxy[i][j] = d[i], dd[i]
+		}
+	}
+
+	cx, cy := &intervalCache{x: grid.X}, &intervalCache{x: grid.Y}
+	eval := func(x, y float64, dx, dy bool) float64 {
+		i, j := cx.find(x), cy.find(y)
+		hx, hy := grid.X[i+1]-grid.X[i], grid.Y[j+1]-grid.Y[j]
+		vx, sx := hermiteWeights((x-grid.X[i])/hx, hx, dx)
+		vy, sy := hermiteWeights((y-grid.Y[j])/hy, hy, dy)
+		res := 0.
+		for k := range 2 {
+			for l := range 2 {
+				res += vx[k]*vy[l]*grid.Z[i+k][j+l] + sx[k]*vy[l]*fx[i+k][j+l] +
+					vx[k]*sy[l]*fy[i+k][j+l] + sx[k]*sy[l]*fxy[i+k][j+l]
+			}
+		}
+		return res
+	}
+
+	return surface(eval), nil
+}
+
+// BicubicSpline builds the tensor-product cubic spline interpolant, which is C² in each variable
+// boundary: NaturalSpline or NotAKnotSpline, applied on all four sides
+func BicubicSpline(grid Grid2D, boundary SplineBoundary) (Surface, error) {
+	// Check input
+	if err := checkGrid2D(grid); err != nil {
+		return Surface{}, err
+	}
+	if boundary != NaturalSpline && boundary != NotAKnotSpline {
+		return Surface{}, ErrWrongInput
+	}
+
+	// Second derivatives: Mx = f_xx along the columns, My = f_yy along the rows, Mxy = f_xxyy
+	n, m := len(grid.X), len(grid.Y)
+	Mx, My, Mxy := make([][]float64, n), make([][]float64, n), make([][]float64, n)
+	for i := range n {
+		var err error
+		My[i], err = splineMoments(grid.Y, grid.Z[i], boundary, 0, 0)
+		if err != nil {
+			return Surface{}, err
+		}
+		Mx[i] = make([]float64, m)
+		Mxy[i] = make([]float64, m)
+	}
+	column, myColumn := make([]float64, n), make([]float64, n)
+	for j := range m {
+		for i := range n {
+			column[i], myColumn[i] = grid.Z[i][j], My[i][j]
+		}
+		mx, err := splineMoments(grid.X, column, boundary, 0, 0)
+		if err != nil {
+			return Surface{}, err
+		}
+		mxy, err := splineMoments(grid.X, myColumn, boundary, 0, 0)
+		if err != nil {
+			return Surface{}, err
+		}
+		for i := range n {
+			Mx[i][j], Mxy[i][j] = mx[i], mxy[i]
+		}
+	}
+
+	cx, cy := &intervalCache{x: grid.X}, &intervalCache{x: grid.Y}
+	eval := func(x, y float64, dx, dy bool) float64 {
+		i, j := cx.find(x), cy.find(y)
+		ax, cxw := splineWeights(grid.X[i], grid.X[i+1], x, dx)
+		ay, cyw := splineWeights(grid.Y[j], grid.Y[j+1], y, dy)
+		res := 0.
+		for k := range 2 {
+			for l := range 2 {
+				res += ax[k]*ay[l]*grid.Z[i+k][j+l] + cxw[k]*ay[l]*Mx[i+k][j+l] +
+					ax[k]*cyw[l]*My[i+k][j+l] + cxw[k]*cyw[l]*Mxy[i+k][j+l]
+			}
+		}
+		return res
+	}
+
+	return surface(eval), nil
+}
+
+// surface builds a Surface from an evaluator of the value or one of the partial derivatives
+func surface(eval func(x, y float64, dx, dy bool) float64) Surface {
+	return Surface{
+		Value: func(x, y float64) float64 { return eval(x, y, false, false) },
+		DX:    func(x, y float64) float64 { return eval(x, y, true, false) },
+		DY:    func(x, y float64) float64 { return eval(x, y, false, true) },
+	}
+}
+
+// checkGrid2D checks that the grid has at least two strictly increasing coordinates on each axis and a value for every node
+func checkGrid2D(grid Grid2D) error {
+	if len(grid.X) < 2 || len(grid.Y) < 2 || len(grid.Z) != len(grid.X) {
+		return ErrWrongInput
+	}
+	for _, axis := range [][]float64{grid.X, grid.Y} {
+		for i := 1; i < len(axis); i++ {
+			if !(axis[i] > axis[i-1]) {
+				return ErrWrongInput
+			}
+		}
+	}
+	for _, row := range grid.Z {
+		if len(row) != len(grid.Y) {
+			return ErrWrongInput
+		}
+	}
+	return nil
+}
+
+// equallySpaced reports whether the increasing coordinates are equally spaced up to rounding
+func equallySpaced(x []float64) bool {
+	h := (x[len(x)-1] - x[0]) / float64(len(x)-1)
+	for i := 1; i < len(x); i++ {
+		if math.Abs(x[i]-x[i-1]-h) > 1e-9*h {
+			return false
+		}
+	}
+	return true
+}
+
+// keysGhost returns the value beyond the end f0 of a line of n values f0, f1, f2 from Keys' boundary condition,
+// or by linear extension if there are only two values
+func keysGhost(f0, f1, f2 float64, n int) float64 {
+	if n < 3 {
+		return 2*f0 - f1
+	}
+	return 3*f0 - 3*f1 + f2
+}
+
+// keysWeights returns the weights of f_{i-1}, f_i, f_{i+1}, f_{i+2} in Keys' cubic convolution at t = (x - x_i)/h,
+// or of their derivatives with respect to t
+func keysWeights(t float64, derivative bool) [4]float64 {
+	if derivative {
+		return [4]float64{
+			(-3*t*t + 4*t - 1) / 2,
+			(9*t*t - 10*t) / 2,
+			(-9*t*t + 8*t + 1) / 2,
+			(3*t*t - 2*t) / 2,
+		}
+	}
+	t2, t3 := t*t, t*t*t
+	return [4]float64{
+		(-t3 + 2*t2 - t) / 2,
+		(3*t3 - 5*t2 + 2) / 2,
+		(-3*t3 + 4*t2 + t) / 2,
+		(t3 - t2) / 2,
+	}
+}
+
+// hermiteWeights returns the weights of the values and of the derivatives at both ends of a cell of length h
+// in the cubic Hermite interpolant at t = (x - x_i)/h, or of their derivatives with respect to x
+func hermiteWeights(t, h float64, derivative bool) ([2]float64, [2]float64) {
+	if derivative {
+		return [2]float64{6 * t * (t - 1) / h, -6 * t * (t - 1) / h},
+			[2]float64{3*t*t - 4*t + 1, 3*t*t - 2*t}
+	}
+	u := 1 - t
+	return [2]float64{(1 + 2*t) * u * u, t * t * (3 - 2*t)},
+		[2]float64{h * t * u * u, -h * t * t * u}
+}
+
+// splineWeights returns the weights of the values and of the second derivatives at both ends of the cell [x0, x1]
+// in the cubic spline at x, or of their derivatives with respect to x
+func splineWeights(x0, x1, x float64, derivative bool) ([2]float64, [2]float64) {
+	h, a, b := x1-x0, x1-x, x-x0
+	if derivative {
+		return [2]float64{-1 / h, 1 / h},
+			[2]float64{(h - 3*a*a/h) / 6, (3*b*b/h - h) / 6}
+	}
+	return [2]float64{a / h, b / h},
+		[2]float64{(a*a*a/h - a*h) / 6, (b*b*b/h - b*h) / 6}
+}
+
+// gridDerivatives estimates the derivative of y at the increasing nodes x by three-point finite differences,
+// which are exact for quadratics, or by the secant if there are only two nodes
+func gridDerivatives(x, y []float64) []float64 {
+	d := make([]float64, len(x))
+	for k := range d {
+		first, w := derivativeWeights(x, k)
+		for j, c := range w {
+			if c != 0 {
+				d[k] += c * y[first+j]
+			}
+		}
+	}
+	return d
+}
@@ -0,0 +1,195 @@
+package numericalanalysis_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	numericalanalysis "github.com/Russia9/numerical-analysis"
+)
+
+// tabulate builds the grid of f at the nodes
+func tabulate(f numericalanalysis.Func2D, x, y []float64) numericalanalysis.Grid2D {
+	grid := numericalanalysis.Grid2D{X: x, Y: y, Z: make([][]float64, len(x))}
+	for i := range x {
+		grid.Z[i] = make([]float64, len(y))
+		for j := range y {
+			grid.Z[i][j] = f(x[i], y[j])
+		}
+	}
+	return grid
+}
+
+// checkSurface compares the surface and its partial derivatives with f at random points of [x0, x1]×[y0, y1]
+func checkSurface(t *testing.T, s numericalanalysis.Surface, f, fx, fy numericalanalysis.Func2D, x0, x1, y0, y1, tol float64) {
+	t.Helper()
+	rng := rand.New(rand.NewSource(15))
+	for range 200 {
+		x, y := x0+(x1-x0)*rng.Float64(), y0+(y1-y0)*rng.Float64()
+		if got, want := s.Value(x, y), f(x, y); math.Abs(got-want) > tol {
+			t.Fatalf("Value(%v, %v) = %v, want %v", x, y, got, want)
+		}
+		if got, want := s.DX(x, y), fx(x, y); math.Abs(got-want) > 10*tol {
+			t.Fatalf("DX(%v, %v) = %v, want %v", x, y, got, want)
+		}
+		if got, want := s.DY(x, y), fy(x, y); math.Abs(got-want) > 10*tol {
+			t.Fatalf("DY(%v, %v) = %v, want %v", x, y, got, want)
+		}
+	}
+}
+
+// Biquadratic test function, reproduced exactly by the convolution and Hermite interpolants
+func biquadratic(x, y float64) float64  { return x*x*y - 2*x*y*y + x*x*y*y + 3 }
+func biquadraticX(x, y float64) float64 { return 2*x*y - 2*y*y + 2*x*y*y }
+func biquadraticY(x, y float64) float64 { return x*x - 4*x*y + 2*x*x*y }
+
+// uniform returns n equally spaced nodes on [a, b]
+func uniform(a, b float64, n int) []float64 {
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = a + (b-a)*float64(i)/float64(n-1)
+	}
+	return x
+}
+
+func TestGridFromPoints(t *testing.T) {
+	t.Run("shuffled points", func(t *testing.T) {
+		want := tabulate(biquadratic, []float64{-1, 0, 2}, []float64{0, 0.5})
+		var points []numericalanalysis.Point3D
+		for j, y := range want.Y {
+			for i, x := range want.X {
+				points = append(points, numericalanalysis.Point3D{X: x, Y: y, Z: want.Z[i][j]})
+			}
+		}
+		rand.New(rand.NewSource(16)).Shuffle(len(points), func(i, j int) { points[i], points[j] = points[j], points[i] })
+
+		grid, err := numericalanalysis.GridFromPoints(points)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		assertSlice(t, "X", grid.X, want.X, 0)
+		assertSlice(t, "Y", grid.Y, want.Y, 0)
+		for i := range want.Z {
+			assertSlice(t, "Z", grid.Z[i], want.Z[i], 0)
+		}
+	})
+
+	t.Run("wrong input", func(t *testing.T) {
+		tests := map[string][]numericalanalysis.Point3D{
+			"one row":        {{X: 0, Y: 0}, {X: 1, Y: 0}},
+			"missing node":   {{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 0, Y: 1}},
+			"duplicate node": {{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 0, Y: 1}, {X: 0, Y: 1}},
+		}
+		for name, points := range tests {
+			if _, err := numericalanalysis.GridFromPoints(points); !errors.Is(err, numericalanalysis.ErrWrongInput) {
+				t.Errorf("%s: err = %v, want %v", name, err, numericalanalysis.ErrWrongInput)
+			}
+		}
+	})
+}
+
+func TestBicubicConvolution(t *testing.T) {
+	t.Run("reproduces a biquadratic", func(t *testing.T) {
+		s, err := numericalanalysis.BicubicConvolution(tabulate(biquadratic, uniform(-1, 2, 7), uniform(0, 1, 5)))
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		checkSurface(t, s, biquadratic, biquadraticX, biquadraticY, -1, 2, 0, 1, 1e-12)
+	})
+
+	t.Run("converges for smooth data", func(t *testing.T) {
+		f := func(x, y float64) float64 { return math.Sin(x) * math.Cos(y) }
+		fx := func(x, y float64) float64 { return math.Cos(x) * math.Cos(y) }
+		fy := func(x, y float64) float64 { return -math.Sin(x) * math.Sin(y) }
+		s, err := numericalanalysis.BicubicConvolution(tabulate(f, uniform(0, 3, 31), uniform(-1, 1, 21)))
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		checkSurface(t, s, f, fx, fy, 0, 3, -1, 1, 5e-4)
+	})
+
+	t.Run("wrong input", func(t *testing.T) {
+		uneven := tabulate(biquadratic, []float64{0, 1, 3}, []float64{0, 1})
+		if _, err := numericalanalysis.BicubicConvolution(uneven); !errors.Is(err, numericalanalysis.ErrWrongInput) {
+			t.Errorf("uneven: err = %v, want %v", err, numericalanalysis.ErrWrongInput)
+		}
+		ragged := numericalanalysis.Grid2D{X: []float64{0, 1}, Y: []float64{0, 1}, Z: [][]float64{{1, 2}, {3}}}
+		if _, err := numericalanalysis.BicubicConvolution(ragged); !errors.Is(err, numericalanalysis.ErrWrongInput) {
+			t.Errorf("ragged: err = %v, want %v", err, numericalanalysis.ErrWrongInput)
+		}
+	})
+}
+
+func TestBicubicHermite(t *testing.T) {
+	t.Run("reproduces a biquadratic on an uneven grid", func(t *testing.T) {
+		s, err := numericalanalysis.BicubicHermite(tabulate(biquadratic, []float64{-1, -0.2, 0.5, 2}, []float64{0, 0.1, 0.6, 1}))
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		checkSurface(t, s, biquadratic, biquadraticX, biquadraticY, -1, 2, 0, 1, 1e-12)
+	})
+
+	t.Run("derivatives are continuous across cells", func(t *testing.T) {
+		f := func(x, y float64) float64 { return math.Exp(x) * math.Sin(3*y) }
+		s, err := numericalanalysis.BicubicHermite(tabulate(f, uniform(0, 1, 6), uniform(0, 1, 6)))
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		const eps = 1e-9
+		for _, y := range []float64{0.13, 0.5, 0.77} {
+			if l, r := s.DX(0.4-eps, y), s.DX(0.4+eps, y); math.Abs(l-r) > 1e-6 {
+				t.Errorf("DX jumps at x = 0.4, y = %v: %v, %v", y, l, r)
+			}
+		}
+	})
+}
+
+func TestBicubicSpline(t *testing.T) {
+	t.Run("not-a-knot reproduces a bicubic", func(t *testing.T) {
+		f := func(x, y float64) float64 { return x*x*x*y*y - y*y*y + x*y }
+		fx := func(x, y float64) float64 { return 3*x*x*y*y + y }
+		fy := func(x, y float64) float64 { return 2*x*x*x*y - 3*y*y + x }
+		s, err := numericalanalysis.BicubicSpline(tabulate(f, []float64{0, 0.3, 1, 1.2, 2}, []float64{-1, 0, 0.4, 1}), numericalanalysis.NotAKnotSpline)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		checkSurface(t, s, f, fx, fy, 0, 2, -1, 1, 1e-11)
+	})
+
+	t.Run("grid lines are one-dimensional splines", func(t *testing.T) {
+		f := func(x, y float64) float64 { return math.Cos(x + 2*y) }
+		grid := tabulate(f, []float64{0, 0.5, 0.7, 1.5, 2}, []float64{0, 1, 1.5})
+		s, err := numericalanalysis.BicubicSpline(grid, numericalanalysis.NaturalSpline)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		points := make([]numericalanalysis.Point2D, len(grid.X))
+		for i, x := range grid.X {
+			points[i] = numericalanalysis.Point2D{X: x, Y: grid.Z[i][1]}
+		}
+		line, err := numericalanalysis.NewCubicSpline(points, numericalanalysis.NaturalSpline, 0, 0)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		for x := 0.; x <= 2; x += 0.1 {
+			if got, want := s.Value(x, 1), line.Eval(x); math.Abs(got-want) > 1e-14 {
+				t.Errorf("Value(%v, 1) = %v, want %v", x, got, want)
+			}
+			if got, want := s.DX(x, 1), line.Derivative(x); math.Abs(got-want) > 1e-13 {
+				t.Errorf("DX(%v, 1) = %v, want %v", x, got, want)
+			}
+		}
+	})
+
+	t.Run("wrong input", func(t *testing.T) {
+		grid := tabulate(biquadratic, []float64{0, 1}, []float64{0, 1})
+		if _, err := numericalanalysis.BicubicSpline(grid, numericalanalysis.ClampedSpline); !errors.Is(err, numericalanalysis.ErrWrongInput) {
+			t.Errorf("err = %v, want %v", err, numericalanalysis.ErrWrongInput)
+		}
+		decreasing := tabulate(biquadratic, []float64{1, 0}, []float64{0, 1})
+		if _, err := numericalanalysis.BicubicSpline(decreasing, numericalanalysis.NaturalSpline); !errors.Is(err, numericalanalysis.ErrWrongInput) {
+			t.Errorf("err = %v, want %v", err, numericalanalysis.ErrWrongInput)
+		}
+	})
+}
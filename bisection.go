@@ -1,14 +1,25 @@
 package numericalanalysis
 
 import (
+	"context"
 	"math"
 )
 
 func BisectionExtremum(f Func1D, a, b float64, tol float64, max bool) (float64, error) {
+	return BisectionExtremumContext(context.Background(), 0, f, a, b, tol, max)
+}
+
+// BisectionExtremumContext is BisectionExtremum that stops on ctx cancellation or after maxEvals evaluations of f (0 = unlimited)
+func BisectionExtremumContext(ctx context.Context, maxEvals int, f Func1D, a, b float64, tol float64, max bool) (float64, error) {
 	// Check input
 	if a >= b || tol <= 0 {
 		return 0, ErrWrongInput
 	}
+	budget, err := newEvalBudget(ctx, maxEvals)
+	if err != nil {
+		return 0, err
+	}
+	f = budget.func1D(f)
 
 	// Loop until tolerance is met
 	for math.Abs(b-a) > tol {
@@ -28,16 +39,31 @@ func BisectionExtremum(f Func1D, a, b float64, tol float64, max bool) (float64,
 				b = x
 			}
 		}
+
+		// Check cancellation and budget
+		if err := budget.check(); err != nil {
+			return 0, err
+		}
 	}
 
 	return (a + b) / 2, nil
 }
 
 func BisectionValue(f Func1D, a, b, value float64, tol float64, backward bool) (float64, error) {
+	return BisectionValueContext(context.Background(), 0, f, a, b, value, tol, backward)
+}
+
+// BisectionValueContext is BisectionValue that stops on ctx cancellation or after maxEvals evaluations of f (0 = unlimited)
+func BisectionValueContext(ctx context.Context, maxEvals int, f Func1D, a, b, value float64, tol float64, backward bool) (float64, error) {
 	// Check input
 	if a >= b || tol <= 0 {
 		return 0, ErrWrongInput
 	}
+	budget, err := newEvalBudget(ctx, maxEvals)
+	if err != nil {
+		return 0, err
+	}
+	f = budget.func1D(f)
 
 	// Loop until tolerance is met
 	for math.Abs(f((a+b)/2)-value) > tol {
@@ -57,6 +83,16 @@ func BisectionValue(f Func1D, a, b, value float64, tol float64, backward bool) (
 				a = x
 			}
 		}
+
+		// Check cancellation and budget
+		if err := budget.check(); err != nil {
+			return 0, err
+		}
+	}
+
+	// The loop condition is false for NaN, so check once more
+	if err := budget.check(); err != nil {
+		return 0, err
 	}
 
 	return (a + b) / 2, nil
@@ -0,0 +1,101 @@
+package numericalanalysis
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+)
+
+// budget.go
+// Cancellation and evaluation budgets for iterative solvers
+
+// evalBudget tracks context cancellation and the number of function evaluations of an iterative solver.
+// Once the budget is exhausted the wrapped functions return NaN without calling the user function,
+// and the next check reports ErrBudgetExceeded. The wrapped functions may be called concurrently.
+type evalBudget struct {
+	mu       sync.Mutex
+	ctx      context.Context
+	maxEvals int // 0 means unlimited
+	evals    int
+	exceeded bool
+}
+
+func newEvalBudget(ctx context.Context, maxEvals int) (*evalBudget, error) {
+	if ctx == nil || maxEvals < 0 {
+		return nil, ErrWrongInput
+	}
+	return &evalBudget{ctx: ctx, maxEvals: maxEvals}, nil
+}
+
+// check returns an error if the context is done or an evaluation was refused
+func (b *evalBudget) check() error {
+	if err := b.ctx.Err(); err != nil {
+		return fmt.Errorf("%w: %w", ErrCanceled, err)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.exceeded {
+		return ErrBudgetExceeded
+	}
+	return nil
+}
+
+// spend counts one evaluation, returning false if the budget is exhausted
+func (b *evalBudget) spend() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.maxEvals > 0 && b.evals >= b.maxEvals {
+		b.exceeded = true
+		return false
+	}
+	b.evals++
+	return true
+}
+
+// func1D wraps a function of one variable
+func (b *evalBudget) func1D(f func(x float64) float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if !b.spend() {
+			return math.NaN()
+		}
+		return f(x)
+	}
+}
+
+// funcVec wraps a function of many variables
+func (b *evalBudget) funcVec(f func(x []float64) float64) func(x []float64) float64 {
+	if f == nil {
+		return nil
+	}
+	return func(x []float64) float64 {
+		if !b.spend() {
+			return math.NaN()
+		}
+		return f(x)
+	}
+}
+
+// funcsVec wraps a system of functions of many variables
+func (b *evalBudget) funcsVec(f []func(x []float64) float64) []func(x []float64) float64 {
+	res := make([]func(x []float64) float64, len(f))
+	for i := range f {
+		res[i] = b.funcVec(f[i])
+	}
+	return res
+}
+
+// funcSystem wraps a system of differential equations
+func (b *evalBudget) funcSystem(f FuncSystem) FuncSystem {
+	res := make(FuncSystem, len(f))
+	for i := range f {
+		fi := f[i]
+		res[i] = func(fromRight bool, x float64, y ...float64) float64 {
+			if !b.spend() {
+				return math.NaN()
+			}
+			return fi(fromRight, x, y...)
+		}
+	}
+	return res
+}
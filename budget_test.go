@@ -0,0 +1,172 @@
+package numericalanalysis_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	numericalanalysis "github.com/Russia9/numerical-analysis"
+)
+
+func TestContextSolvers(t *testing.T) {
+	quadratic := func(x []float64) float64 { return (x[0]-1)*(x[0]-1) + (x[1]+2)*(x[1]+2) }
+	deltaX := []float64{1e-5, 1e-5}
+	simplex, _ := numericalanalysis.NelderMeadSimplex([]float64{0, 0}, []float64{1, 1})
+	system := []func(u []float64) float64{
+		func(u []float64) float64 { return u[0]*u[0] + u[1]*u[1] - 4 },
+		func(u []float64) float64 { return u[0] - u[1] },
+	}
+	ode := numericalanalysis.FuncSystem{
+		func(fromRight bool, x float64, y ...float64) float64 { return y[0] },
+	}
+	never := func(x float64, y ...float64) (bool, bool) { return false, false }
+
+	solvers := map[string]func(ctx context.Context, maxEvals int) error{
+		"BisectionExtremum": func(ctx context.Context, maxEvals int) error {
+			_, err := numericalanalysis.BisectionExtremumContext(ctx, maxEvals, func(x float64) float64 { return (x - 2) * (x - 2) }, 0, 4, 1e-9, false)
+			return err
+		},
+		"BisectionValue": func(ctx context.Context, maxEvals int) error {
+			_, err := numericalanalysis.BisectionValueContext(ctx, maxEvals, func(x float64) float64 { return x * x }, 0, 4, 2, 1e-9, false)
+			return err
+		},
+		"DampedNewtonExtremum": func(ctx context.Context, maxEvals int) error {
+			_, err := numericalanalysis.DampedNewtonExtremumContext(ctx, maxEvals, quadratic, []float64{5, 5}, deltaX, 100, 0.5, 1e-6, 10)
+			return err
+		},
+		"SENewton": func(ctx context.Context, maxEvals int) error {
+			_, err := numericalanalysis.SENewtonContext(ctx, maxEvals, system, []float64{1, 2}, []float64{1e-6, 1e-6}, 1e-8)
+			return err
+		},
+		"EulerMethod": func(ctx context.Context, maxEvals int) error {
+			_, err := numericalanalysis.EulerMethodContext(ctx, maxEvals, ode, 0, []float64{1}, nil, 0.1, func(x float64, y ...float64) (bool, bool) { return false, x >= 1 })
+			return err
+		},
+		"ModifiedEulerMethod": func(ctx context.Context, maxEvals int) error {
+			_, err := numericalanalysis.ModifiedEulerMethodContext(ctx, maxEvals, ode, 0, []float64{1}, nil, 0.1, func(x float64, y ...float64) (bool, bool) { return false, x >= 1 })
+			return err
+		},
+		"RungeKuttaMethod": func(ctx context.Context, maxEvals int) error {
+			_, err := numericalanalysis.RungeKuttaMethodContext(ctx, maxEvals, ode, 0, []float64{1}, nil, 0.1, func(x float64, y ...float64) (bool, bool) { return false, x >= 1 })
+			return err
+		},
+		"BFGSExtremum": func(ctx context.Context, maxEvals int) error {
+			_, err := numericalanalysis.BFGSExtremumContext(ctx, maxEvals, quadratic, nil, []float64{5, 5}, deltaX, 1e-6, 100)
+			return err
+		},
+		"LBFGSExtremum": func(ctx context.Context, maxEvals int) error {
+			_, err := numericalanalysis.LBFGSExtremumContext(ctx, maxEvals, quadratic, nil, []float64{5, 5}, deltaX, 5, 1e-6, 100)
+			return err
+		},
+		"ConjugateGradientExtremum": func(ctx context.Context, maxEvals int) error {
+			_, err := numericalanalysis.ConjugateGradientExtremumContext(ctx, maxEvals, quadratic, nil, []float64{5, 5}, deltaX, 1e-6, 100)
+			return err
+		},
+		"NelderMeadExtremum": func(ctx context.Context, maxEvals int) error {
+			_, err := numericalanalysis.NelderMeadExtremumContext(ctx, maxEvals, quadratic, simplex, false, 1e-6, 1e-9, 1000, 0)
+			return err
+		},
+		"ProjectedLBFGSExtremum": func(ctx context.Context, maxEvals int) error {
+			_, err := numericalanalysis.ProjectedLBFGSExtremumContext(ctx, maxEvals, quadratic, nil, []float64{5, 5}, []float64{-10, -10}, []float64{10, 10}, deltaX, 5, 1e-6, 100)
+			return err
+		},
+		"AugmentedLagrangianExtremum": func(ctx context.Context, maxEvals int) error {
+			eq := []func(x []float64) float64{func(x []float64) float64 { return x[0] + x[1] }}
+			_, err := numericalanalysis.AugmentedLagrangianExtremumContext(ctx, maxEvals, quadratic, eq, nil, []float64{0, 0}, []float64{1e-4, 1e-4}, 1, 1e-6, 50)
+			return err
+		},
+		"SimulatedAnnealingExtremum": func(ctx context.Context, maxEvals int) error {
+			_, err := numericalanalysis.SimulatedAnnealingExtremumContext(ctx, maxEvals, quadratic, []float64{-5, -5}, []float64{5, 5}, 1, 0.99, 500, rand.New(rand.NewSource(1)), false)
+			return err
+		},
+		"DifferentialEvolutionExtremum": func(ctx context.Context, maxEvals int) error {
+			_, err := numericalanalysis.DifferentialEvolutionExtremumContext(ctx, maxEvals, quadratic, []float64{-5, -5}, []float64{5, 5}, 10, 0.8, 0.9, 100, 1e-9, rand.New(rand.NewSource(1)), false)
+			return err
+		},
+	}
+
+	for name, solve := range solvers {
+		t.Run(name+" - unlimited", func(t *testing.T) {
+			if err := solve(context.Background(), 0); err != nil {
+				t.Errorf("err = %v, want nil", err)
+			}
+		})
+
+		t.Run(name+" - canceled", func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			err := solve(ctx, 0)
+			if !errors.Is(err, numericalanalysis.ErrCanceled) || !errors.Is(err, context.Canceled) {
+				t.Errorf("err = %v, want ErrCanceled wrapping context.Canceled", err)
+			}
+			if errors.Is(err, numericalanalysis.ErrDidNotConverge) {
+				t.Errorf("err = %v, must not be ErrDidNotConverge", err)
+			}
+		})
+
+		t.Run(name+" - budget exceeded", func(t *testing.T) {
+			err := solve(context.Background(), 3)
+			if !errors.Is(err, numericalanalysis.ErrBudgetExceeded) || !errors.Is(err, numericalanalysis.ErrDidNotConverge) {
+				t.Errorf("err = %v, want ErrBudgetExceeded", err)
+			}
+		})
+	}
+
+	t.Run("input validation - negative budget", func(t *testing.T) {
+		_, err := numericalanalysis.BisectionValueContext(context.Background(), -1, math.Sqrt, 0, 4, 1, 1e-9, false)
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+
+	t.Run("BisectionValue - unreachable value stops on budget", func(t *testing.T) {
+		// x^2 never reaches -1 on [0, 4]
+		evals := 0
+		f := func(x float64) float64 {
+			evals++
+			return x * x
+		}
+
+		_, err := numericalanalysis.BisectionValueContext(context.Background(), 1000, f, 0, 4, -1, 1e-9, false)
+		if !errors.Is(err, numericalanalysis.ErrBudgetExceeded) {
+			t.Errorf("err = %v, want ErrBudgetExceeded", err)
+		}
+		if evals != 1000 {
+			t.Errorf("evals = %v, want 1000", evals)
+		}
+	})
+
+	t.Run("AugmentedLagrangianExtremum - constraint evaluations count towards the budget", func(t *testing.T) {
+		evals := 0
+		f := func(x []float64) float64 {
+			evals++
+			return x[0]*x[0] + x[1]*x[1]
+		}
+		eq := []func(x []float64) float64{func(x []float64) float64 {
+			evals++
+			return x[0] + x[1] - 1
+		}}
+
+		_, err := numericalanalysis.AugmentedLagrangianExtremumContext(context.Background(), 100, f, eq, nil, []float64{0, 0}, []float64{1e-4, 1e-4}, 1, 1e-6, 50)
+		if !errors.Is(err, numericalanalysis.ErrBudgetExceeded) {
+			t.Errorf("err = %v, want ErrBudgetExceeded", err)
+		}
+		if evals != 100 {
+			t.Errorf("evals = %v, want 100", evals)
+		}
+	})
+
+	t.Run("RungeKuttaMethod - never-true stop callback stops on deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := numericalanalysis.RungeKuttaMethodContext(ctx, 0, ode, 0, []float64{1}, nil, 1e-6, never)
+		if !errors.Is(err, numericalanalysis.ErrCanceled) || !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("err = %v, want ErrCanceled wrapping context.DeadlineExceeded", err)
+		}
+	})
+}
@@ -1,6 +1,9 @@
 package numericalanalysis
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 type Point2D struct {
 	X float64
@@ -21,8 +24,20 @@ type Point3D struct {
 
 var ErrNoSolution = errors.New("no solution")
 
+var ErrInfeasible = fmt.Errorf("infeasible problem: %w", ErrNoSolution)
+
+var ErrUnbounded = fmt.Errorf("unbounded problem: %w", ErrNoSolution)
+
 var ErrWrongInput = errors.New("wrong input")
 
 var ErrSingularMatrix = errors.New("singular matrix")
 
 var ErrDidNotConverge = errors.New("did not converge")
+
+var ErrAborted = errors.New("aborted")
+
+var ErrCanceled = errors.New("canceled")
+
+var ErrBudgetExceeded = fmt.Errorf("evaluation budget exceeded: %w", ErrDidNotConverge)
+
+var ErrOutOfDomain = fmt.Errorf("out of domain: %w", ErrWrongInput)
@@ -0,0 +1,300 @@
+package numericalanalysis
+
+import (
+	"context"
+	"math"
+)
+
+// constrained.go
+// Bound and general constrained minimizers
+
+// ConstrainedResult is the solution of a constrained minimization problem
+type ConstrainedResult struct {
+	X         []float64 // Solution
+	Lambda    []float64 // Lagrange multipliers of the equality constraints
+	Mu        []float64 // Lagrange multipliers of the inequality constraints, Mu >= 0
+	Violation float64   // Maximum constraint violation at X
+}
+
+// ProjectedLBFGSExtremum method for finding a minimum of a function of many variables subject to bounds lower <= x <= upper.
+// Steps are computed by L-BFGS on the free variables and projected back onto the box.
+// f: function to minimize
+// grad: gradient of f; if nil, central differences with deltaX steps are used (f may be evaluated up to deltaX outside the box)
+// x0: initial guess for the solution (projected onto the box)
+// lower, upper: bounds for each variable, infinite values are allowed
+// deltaX: step size for each variable (for differential calculations, ignored if grad is set)
+// m: number of stored correction pairs
+// eps: tolerance for the projected gradient norm
+// maxIter: maximum number of iterations
+func ProjectedLBFGSExtremum(f func(x []float64) float64, grad func(x []float64) []float64, x0, lower, upper []float64, deltaX []float64, m int, eps float64, maxIter int) ([]float64, error) {
+	return ProjectedLBFGSExtremumContext(context.Background(), 0, f, grad, x0, lower, upper, deltaX, m, eps, maxIter)
+}
+
+// ProjectedLBFGSExtremumContext is ProjectedLBFGSExtremum that stops on ctx cancellation or after maxEvals evaluations of f (0 = unlimited)
+func ProjectedLBFGSExtremumContext(ctx context.Context, maxEvals int, f func(x []float64) float64, grad func(x []float64) []float64, x0, lower, upper []float64, deltaX []float64, m int, eps float64, maxIter int) ([]float64, error) {
+	n := len(x0)
+
+	// Check input
+	if n == 0 || len(lower) != n || len(upper) != n || m <= 0 || eps <= 0 || maxIter <= 0 {
+		return nil, ErrWrongInput
+	}
+	for i := range n {
+		if lower[i] > upper[i] {
+			return nil, ErrWrongInput
+		}
+	}
+	budget, err := newEvalBudget(ctx, maxEvals)
+	if err != nil {
+		return nil, err
+	}
+	f = budget.funcVec(f)
+	grad, err = gradientOrDifferences(f, grad, deltaX, n)
+	if err != nil {
+		return nil, err
+	}
+
+	// Projection onto the box
+	project := func(x []float64) []float64 {
+		p := make([]float64, n)
+		for i := range x {
+			p[i] = math.Min(math.Max(x[i], lower[i]), upper[i])
+		}
+		return p
+	}
+
+	x := project(x0)
+	fx := f(x)
+	g := grad(x)
+
+	// Correction pairs history
+	var sHist, yHist [][]float64
+	var rhoHist []float64
+
+	for range maxIter {
+		// Check cancellation and budget
+		if err := budget.check(); err != nil {
+			return nil, err
+		}
+
+		// Projected gradient P(x - g) - x, and the active set
+		active := make([]bool, n)
+		pgNorm := 0.
+		for i := range n {
+			pg := math.Min(math.Max(x[i]-g[i], lower[i]), upper[i]) - x[i]
+			pgNorm = math.Max(pgNorm, math.Abs(pg))
+			active[i] = (x[i] <= lower[i] && g[i] > 0) || (x[i] >= upper[i] && g[i] < 0)
+		}
+		if pgNorm < eps {
+			return x, nil
+		}
+
+		// Two-loop recursion restricted to the free variables
+		q := make([]float64, n)
+		for i := range n {
+			if !active[i] {
+				q[i] = g[i]
+			}
+		}
+		a := make([]float64, len(sHist))
+		for k := len(sHist) - 1; k >= 0; k-- {
+			a[k] = rhoHist[k] * dot(sHist[k], q)
+			for i := range q {
+				q[i] -= a[k] * yHist[k][i]
+			}
+		}
+		gamma := 1.0
+		if l := len(sHist); l > 0 {
+			gamma = dot(sHist[l-1], yHist[l-1]) / dot(yHist[l-1], yHist[l-1])
+		}
+		for i := range q {
+			q[i] *= gamma
+		}
+		for k := range sHist {
+			b := rhoHist[k] * dot(yHist[k], q)
+			for i := range q {
+				q[i] += sHist[k][i] * (a[k] - b)
+			}
+		}
+		d := make([]float64, n)
+		for i := range n {
+			if !active[i] {
+				d[i] = -q[i]
+			}
+		}
+		if dot(d, g) >= 0 { // Not a descent direction, fall back to projected steepest descent
+			sHist, yHist, rhoHist = nil, nil, nil
+			for i := range n {
+				if !active[i] {
+					d[i] = -g[i]
+				}
+			}
+		}
+
+		// Backtracking along the projection arc x(α) = P(x + α d)
+		alpha := 1.0
+		if len(sHist) == 0 {
+			alpha = math.Min(1, 1/Norm(d))
+		}
+		var x1 []float64
+		var fx1 float64
+		accepted := false
+		for range wolfeMaxIts {
+			xa := make([]float64, n)
+			for i := range n {
+				xa[i] = x[i] + alpha*d[i]
+			}
+			x1 = project(xa)
+
+			step := make([]float64, n)
+			for i := range n {
+				step[i] = x1[i] - x[i]
+			}
+			fx1 = f(x1)
+			if fx1 <= fx+wolfeC1*dot(g, step) {
+				accepted = true
+				break
+			}
+			alpha /= 2
+		}
+		if err := budget.check(); err != nil {
+			return nil, err
+		}
+		if !accepted {
+			return nil, ErrDidNotConverge
+		}
+
+		g1 := grad(x1)
+		s := make([]float64, n)
+		y := make([]float64, n)
+		for i := range n {
+			s[i] = x1[i] - x[i]
+			y[i] = g1[i] - g[i]
+		}
+		x, fx, g = x1, fx1, g1
+
+		sy := dot(s, y)
+		if sy <= 1e-12*Norm(s)*Norm(y) { // Curvature condition failed, skip update
+			continue
+		}
+		if len(sHist) == m {
+			sHist, yHist, rhoHist = sHist[1:], yHist[1:], rhoHist[1:]
+		}
+		sHist = append(sHist, s)
+		yHist = append(yHist, y)
+		rhoHist = append(rhoHist, 1/sy)
+	}
+
+	return nil, ErrDidNotConverge
+}
+
+// AugmentedLagrangianExtremum method for finding a minimum of a function of many variables
+// subject to equality constraints h(x) = 0 and inequality constraints g(x) <= 0.
+// Every subproblem is solved with DampedNewtonExtremum limited to 200 iterations, the multipliers are updated
+// at the last iterate of a subproblem that is stopped by the limit or does not converge.
+// f: function to minimize
+// eq: equality constraints h_i(x) = 0
+// ineq: inequality constraints g_j(x) <= 0
+// x0: initial guess for the solution
+// deltaX: step size for each variable (for differential calculations)
+// rho0: initial penalty parameter
+// eps: tolerance for the constraint violation and for the subproblems
+// maxOuter: maximum number of multiplier updates
+func AugmentedLagrangianExtremum(f func(x []float64) float64, eq, ineq []func(x []float64) float64, x0 []float64, deltaX []float64, rho0, eps float64, maxOuter int) (ConstrainedResult, error) {
+	return AugmentedLagrangianExtremumContext(context.Background(), 0, f, eq, ineq, x0, deltaX, rho0, eps, maxOuter)
+}
+
+// AugmentedLagrangianExtremumContext is AugmentedLagrangianExtremum that stops on ctx cancellation
+// or after maxEvals evaluations of f and the constraints together (0 = unlimited)
+func AugmentedLagrangianExtremumContext(ctx context.Context, maxEvals int, f func(x []float64) float64, eq, ineq []func(x []float64) float64, x0 []float64, deltaX []float64, rho0, eps float64, maxOuter int) (ConstrainedResult, error) {
+	n := len(x0)
+
+	// Check input
+	if n == 0 || len(deltaX) != n || rho0 <= 0 || eps <= 0 || maxOuter <= 0 {
+		return ConstrainedResult{}, ErrWrongInput
+	}
+	budget, err := newEvalBudget(ctx, maxEvals)
+	if err != nil {
+		return ConstrainedResult{}, err
+	}
+	f = budget.funcVec(f)
+	eq = budget.funcsVec(eq)
+	ineq = budget.funcsVec(ineq)
+
+	// Subproblems stop after maxSubIter iterations
+	const maxSubIter = 200
+	opts := NewtonOptions{
+		Context:  ctx,
+		Callback: func(it NewtonIteration) bool { return it.Iteration >= maxSubIter },
+	}
+
+	lambda := make([]float64, len(eq))
+	mu := make([]float64, len(ineq))
+	rho := rho0
+
+	// Maximum constraint violation at x
+	violation := func(x []float64) float64 {
+		v := 0.
+		for i := range eq {
+			v = math.Max(v, math.Abs(eq[i](x)))
+		}
+		for j := range ineq {
+			v = math.Max(v, ineq[j](x))
+		}
+		return v
+	}
+
+	x := make([]float64, n)
+	copy(x, x0)
+	prevViolation := math.Inf(1)
+
+	for range maxOuter {
+		// L(x) = f + Σ λh + ρ/2 Σ h² + 1/(2ρ) Σ (max(0, μ + ρg)² - μ²)
+		lagrangian := func(x []float64) float64 {
+			res := f(x)
+			for i := range eq {
+				h := eq[i](x)
+				res += lambda[i]*h + rho/2*h*h
+			}
+			for j := range ineq {
+				p := math.Max(0, mu[j]+rho*ineq[j](x))
+				res += (p*p - mu[j]*mu[j]) / (2 * rho)
+			}
+			return res
+		}
+
+		res, err := DampedNewtonExtremumWithOptions(lagrangian, x, deltaX, 1, 0.5, eps, 64, opts)
+		if budgetErr := budget.check(); budgetErr != nil {
+			return ConstrainedResult{}, budgetErr
+		}
+		if err != nil && err != ErrAborted && err != ErrDidNotConverge {
+			return ConstrainedResult{}, err
+		}
+		if res.X != nil {
+			x = res.X
+		}
+
+		// Update multipliers
+		for i := range eq {
+			lambda[i] += rho * eq[i](x)
+		}
+		for j := range ineq {
+			mu[j] = math.Max(0, mu[j]+rho*ineq[j](x))
+		}
+
+		v := violation(x)
+		if err := budget.check(); err != nil {
+			return ConstrainedResult{}, err
+		}
+		if v < eps {
+			return ConstrainedResult{X: x, Lambda: lambda, Mu: mu, Violation: v}, nil
+		}
+
+		// Increase the penalty if the violation did not decrease enough
+		if v > 0.25*prevViolation {
+			rho *= 10
+		}
+		prevViolation = v
+	}
+
+	return ConstrainedResult{}, ErrDidNotConverge
+}
@@ -0,0 +1,177 @@
+package numericalanalysis_test
+
+import (
+	"math"
+	"testing"
+
+	numericalanalysis "github.com/Russia9/numerical-analysis"
+)
+
+func TestProjectedLBFGSExtremum(t *testing.T) {
+	inf := math.Inf(1)
+
+	t.Run("input validation - inverted bounds", func(t *testing.T) {
+		_, err := numericalanalysis.ProjectedLBFGSExtremum(rosenbrock, rosenbrockGrad, []float64{0, 0}, []float64{1, 0}, []float64{0, 1}, nil, 5, 1e-6, 100)
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+
+	t.Run("input validation - mismatched bounds", func(t *testing.T) {
+		_, err := numericalanalysis.ProjectedLBFGSExtremum(rosenbrock, rosenbrockGrad, []float64{0, 0}, []float64{0}, []float64{1, 1}, nil, 5, 1e-6, 100)
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+
+	t.Run("inactive bounds", func(t *testing.T) {
+		result, err := numericalanalysis.ProjectedLBFGSExtremum(rosenbrock, rosenbrockGrad, []float64{-1.2, 1}, []float64{-inf, -inf}, []float64{inf, inf}, nil, 5, 1e-8, 1000)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(result[0]-1) > 1e-6 || math.Abs(result[1]-1) > 1e-6 {
+			t.Errorf("result = %v, want ~[1 1]", result)
+		}
+	})
+
+	t.Run("rosenbrock function with active upper bound", func(t *testing.T) {
+		// Minimum on the box x <= 0.5 is at (0.5, 0.25)
+		result, err := numericalanalysis.ProjectedLBFGSExtremum(rosenbrock, rosenbrockGrad, []float64{-1.2, 1}, []float64{-2, -2}, []float64{0.5, 2}, nil, 5, 1e-8, 1000)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(result[0]-0.5) > 1e-6 || math.Abs(result[1]-0.25) > 1e-6 {
+			t.Errorf("result = %v, want ~[0.5 0.25]", result)
+		}
+	})
+
+	t.Run("paraboloid with finite differences and both bounds active", func(t *testing.T) {
+		// f(x,y) = (x+1)^2 + (y-3)^2 on [0,1]x[0,2], minimum at (0, 2)
+		f := func(x []float64) float64 {
+			return (x[0]+1)*(x[0]+1) + (x[1]-3)*(x[1]-3)
+		}
+
+		result, err := numericalanalysis.ProjectedLBFGSExtremum(f, nil, []float64{0.5, 0.5}, []float64{0, 0}, []float64{1, 2}, []float64{1e-6, 1e-6}, 5, 1e-6, 1000)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if result[0] != 0 || result[1] != 2 {
+			t.Errorf("result = %v, want [0 2]", result)
+		}
+	})
+}
+
+func TestAugmentedLagrangianExtremum(t *testing.T) {
+	t.Run("input validation - non-positive penalty", func(t *testing.T) {
+		_, err := numericalanalysis.AugmentedLagrangianExtremum(rosenbrock, nil, nil, []float64{0, 0}, []float64{1e-4, 1e-4}, 0, 1e-6, 10)
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+
+	t.Run("input validation - missing steps", func(t *testing.T) {
+		_, err := numericalanalysis.AugmentedLagrangianExtremum(rosenbrock, nil, nil, []float64{0, 0}, []float64{1e-4}, 1, 1e-6, 10)
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+
+	t.Run("equality constraint", func(t *testing.T) {
+		// min x^2 + y^2 s.t. x + y = 1, solution (0.5, 0.5), λ = -1
+		f := func(x []float64) float64 { return x[0]*x[0] + x[1]*x[1] }
+		eq := []func(x []float64) float64{
+			func(x []float64) float64 { return x[0] + x[1] - 1 },
+		}
+
+		result, err := numericalanalysis.AugmentedLagrangianExtremum(f, eq, nil, []float64{0, 0}, []float64{1e-4, 1e-4}, 1, 1e-6, 50)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(result.X[0]-0.5) > 1e-4 || math.Abs(result.X[1]-0.5) > 1e-4 {
+			t.Errorf("X = %v, want ~[0.5 0.5]", result.X)
+		}
+		if math.Abs(result.Lambda[0]+1) > 1e-3 {
+			t.Errorf("Lambda = %v, want ~[-1]", result.Lambda)
+		}
+		if result.Violation >= 1e-6 {
+			t.Errorf("Violation = %v, want < 1e-6", result.Violation)
+		}
+	})
+
+	t.Run("active inequality constraint", func(t *testing.T) {
+		// min (x-2)^2 + (y-1)^2 s.t. x + y <= 2, solution (1.5, 0.5), μ = 1
+		f := func(x []float64) float64 { return (x[0]-2)*(x[0]-2) + (x[1]-1)*(x[1]-1) }
+		ineq := []func(x []float64) float64{
+			func(x []float64) float64 { return x[0] + x[1] - 2 },
+		}
+
+		result, err := numericalanalysis.AugmentedLagrangianExtremum(f, nil, ineq, []float64{0, 0}, []float64{1e-4, 1e-4}, 1, 1e-6, 50)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(result.X[0]-1.5) > 1e-4 || math.Abs(result.X[1]-0.5) > 1e-4 {
+			t.Errorf("X = %v, want ~[1.5 0.5]", result.X)
+		}
+		if math.Abs(result.Mu[0]-1) > 1e-3 {
+			t.Errorf("Mu = %v, want ~[1]", result.Mu)
+		}
+	})
+
+	t.Run("inactive inequality constraint", func(t *testing.T) {
+		// min (x-1)^2 + (y-1)^2 s.t. x + y <= 5, solution (1, 1), μ = 0
+		f := func(x []float64) float64 { return (x[0]-1)*(x[0]-1) + (x[1]-1)*(x[1]-1) }
+		ineq := []func(x []float64) float64{
+			func(x []float64) float64 { return x[0] + x[1] - 5 },
+		}
+
+		result, err := numericalanalysis.AugmentedLagrangianExtremum(f, nil, ineq, []float64{0, 0}, []float64{1e-4, 1e-4}, 1, 1e-6, 50)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(result.X[0]-1) > 1e-4 || math.Abs(result.X[1]-1) > 1e-4 {
+			t.Errorf("X = %v, want ~[1 1]", result.X)
+		}
+		if result.Mu[0] != 0 {
+			t.Errorf("Mu = %v, want [0]", result.Mu)
+		}
+	})
+
+	t.Run("subproblem that does not converge", func(t *testing.T) {
+		// min sqrt|x| + y^2 s.t. x + y = 1 from (0.3, 0.2): Newton steps stall at the cusp x = 0, a local minimum at (0, 1)
+		f := func(x []float64) float64 { return math.Sqrt(math.Abs(x[0])) + x[1]*x[1] }
+		eq := []func(x []float64) float64{
+			func(x []float64) float64 { return x[0] + x[1] - 1 },
+		}
+
+		result, err := numericalanalysis.AugmentedLagrangianExtremum(f, eq, nil, []float64{0.3, 0.2}, []float64{1e-6, 1e-6}, 1, 1e-6, 50)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(result.X[0]) > 1e-4 || math.Abs(result.X[1]-1) > 1e-4 {
+			t.Errorf("X = %v, want ~[0 1]", result.X)
+		}
+	})
+
+	t.Run("mixed constraints", func(t *testing.T) {
+		// min x^2 + y^2 + z^2 s.t. x + y + z = 3, x >= 2, solution (2, 0.5, 0.5)
+		f := func(x []float64) float64 { return x[0]*x[0] + x[1]*x[1] + x[2]*x[2] }
+		eq := []func(x []float64) float64{
+			func(x []float64) float64 { return x[0] + x[1] + x[2] - 3 },
+		}
+		ineq := []func(x []float64) float64{
+			func(x []float64) float64 { return 2 - x[0] },
+		}
+
+		result, err := numericalanalysis.AugmentedLagrangianExtremum(f, eq, ineq, []float64{0, 0, 0}, []float64{1e-4, 1e-4, 1e-4}, 1, 1e-6, 50)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		expected := []float64{2, 0.5, 0.5}
+		for i := range expected {
+			if math.Abs(result.X[i]-expected[i]) > 1e-4 {
+				t.Errorf("X = %v, want ~%v", result.X, expected)
+				break
+			}
+		}
+	})
+}
@@ -0,0 +1,280 @@
+package numericalanalysis
+
+import "math"
+
+// cubature.go
+// Multidimensional integration over boxes and simplices
+
+// IntegralRectangle calculates the integral of f over [x0, x1] × [y0, y1] with the n×n tensor-product Gauss–Legendre rule
+func IntegralRectangle(f Func2D, x0, x1, y0, y1 float64, n int) (float64, error) {
+	return IntegralBox(func(x []float64) float64 { return f(x[0], x[1]) }, []float64{x0, y0}, []float64{x1, y1}, n)
+}
+
+// IntegralBox calculates the integral of f over the box lower <= x <= upper with the tensor-product
+// n-point Gauss–Legendre rule (n^d evaluations), exact for polynomials of degree 2n-1 in every variable
+func IntegralBox(f func(x []float64) float64, lower, upper []float64, n int) (float64, error) {
+	// Check input
+	if err := checkBox(lower, upper); err != nil {
+		return 0, err
+	}
+	rule, err := GaussLegendreRule(n)
+	if err != nil {
+		return 0, err
+	}
+	d := len(lower)
+
+	// Iterate over all index tuples of the tensor product
+	idx := make([]int, d)
+	x := make([]float64, d)
+	res := 0.
+	for {
+		w := 1.
+		for i := range d {
+			c, h := (lower[i]+upper[i])/2, (upper[i]-lower[i])/2
+			x[i] = c + h*rule.Nodes[idx[i]]
+			w *= h * rule.Weights[idx[i]]
+		}
+		res += w * f(x)
+
+		// Next tuple
+		i := 0
+		for ; i < d; i++ {
+			idx[i]++
+			if idx[i] < n {
+				break
+			}
+			idx[i] = 0
+		}
+		if i == d {
+			return res, nil
+		}
+	}
+}
+
+// cubatureBox is a subregion of an adaptive cubature
+type cubatureBox struct {
+	lower, upper []float64
+	value, err   float64
+	split        int // Dimension to split along
+}
+
+// IntegralBoxAdaptive calculates the integral of f over the box lower <= x <= upper with the adaptive Genz–Malik
+// degree 7/5 rule pair (2^d + 2d² + 2d + 1 evaluations per subregion). The subregion with the largest error is halved
+// along the dimension with the largest fourth difference until the total error is below max(absTol, relTol·|I|).
+// One-dimensional problems are delegated to IntegralGaussKronrod.
+// On ErrDidNotConverge the result holds the estimate reached with maxSubdivisions subregions.
+// absTol, relTol: absolute and relative tolerances, at least one of them positive
+// maxSubdivisions: maximum number of subregions
+func IntegralBoxAdaptive(f func(x []float64) float64, lower, upper []float64, absTol, relTol float64, maxSubdivisions int) (QuadratureResult, error) {
+	// Check input
+	if err := checkBox(lower, upper); err != nil {
+		return QuadratureResult{}, err
+	}
+	if absTol < 0 || relTol < 0 || (absTol == 0 && relTol == 0) || maxSubdivisions < 1 {
+		return QuadratureResult{}, ErrWrongInput
+	}
+	if len(lower) == 1 {
+		return IntegralGaussKronrod(func(x float64) float64 { return f([]float64{x}) }, lower[0], upper[0], absTol, relTol, maxSubdivisions)
+	}
+
+	evals := 0
+	g := func(x []float64) float64 {
+		evals++
+		return f(x)
+	}
+
+	boxes := []cubatureBox{genzMalik(g, lower, upper)}
+	for {
+		res := QuadratureResult{Evals: evals}
+		for _, b := range boxes {
+			res.Value += b.value
+			res.Error += b.err
+		}
+		if res.Error <= math.Max(absTol, relTol*math.Abs(res.Value)) {
+			return res, nil
+		}
+		if len(boxes) >= maxSubdivisions {
+			return res, ErrDidNotConverge
+		}
+
+		// Halve the box with the largest error
+		worst := 0
+		for i := range boxes {
+			if boxes[i].err > boxes[worst].err {
+				worst = i
+			}
+		}
+		b := boxes[worst]
+		k := b.split
+		m := (b.lower[k] + b.upper[k]) / 2
+		if m <= b.lower[k] || m >= b.upper[k] { // Box can not be split further
+			return res, ErrDidNotConverge
+		}
+		leftUpper := append([]float64(nil), b.upper...)
+		rightLower := append([]float64(nil), b.lower...)
+		leftUpper[k], rightLower[k] = m, m
+		boxes[worst] = genzMalik(g, b.lower, leftUpper)
+		boxes = append(boxes, genzMalik(g, rightLower, b.upper))
+	}
+}
+
+// genzMalik applies the Genz–Malik degree 7 rule and its embedded degree 5 rule on a box of dimension d >= 2
+func genzMalik(f func(x []float64) float64, lower, upper []float64) cubatureBox {
+	d := len(lower)
+	fd := float64(d)
+
+	// Generator points and weights on [-1, 1]^d, the weights sum to 1
+	l2, l3, l5 := math.Sqrt(9./70), math.Sqrt(9./10), math.Sqrt(9./19)
+	l4 := l3
+	w7 := [5]float64{(12824 - 9120*fd + 400*fd*fd) / 19683, 980. / 6561, (1820 - 400*fd) / 19683, 200. / 19683, 6859. / 19683 / math.Pow(2, fd)}
+	w5 := [4]float64{(729 - 950*fd + 50*fd*fd) / 729, 245. / 486, (265 - 100*fd) / 1458, 25. / 729}
+
+	c := make([]float64, d)
+	h := make([]float64, d)
+	volume := 1.
+	for i := range d {
+		c[i], h[i] = (lower[i]+upper[i])/2, (upper[i]-lower[i])/2
+		volume *= upper[i] - lower[i]
+	}
+
+	// at evaluates f at c shifted by ui·h_i along i and uj·h_j along j
+	x := make([]float64, d)
+	at := func(i int, ui float64, j int, uj float64) float64 {
+		copy(x, c)
+		x[i] += h[i] * ui
+		x[j] += h[j] * uj
+		return f(x)
+	}
+
+	f0 := at(0, 0, 0, 0)
+	var s2, s3, s4, s5 float64
+	split, maxDiff := 0, -1.
+	for i := range d {
+		p2, m2 := at(i, l2, i, 0), at(i, -l2, i, 0)
+		p3, m3 := at(i, l3, i, 0), at(i, -l3, i, 0)
+		s2 += p2 + m2
+		s3 += p3 + m3
+
+		// Fourth difference selects the dimension to split, ties go to the widest side
+		diff := math.Abs(p2 + m2 - 2*f0 - (l2*l2)/(l3*l3)*(p3+m3-2*f0))
+		if diff > maxDiff || (diff == maxDiff && h[i] > h[split]) {
+			split, maxDiff = i, diff
+		}
+
+		for j := i + 1; j < d; j++ {
+			for _, si := range []float64{-1, 1} {
+				for _, sj := range []float64{-1, 1} {
+					s4 += at(i, si*l4, j, sj*l4)
+				}
+			}
+		}
+	}
+
+	// All vertices of the cube scaled by λ5
+	for mask := range 1 << d {
+		for i := range d {
+			if mask&(1<<i) != 0 {
+				x[i] = c[i] + h[i]*l5
+			} else {
+				x[i] = c[i] - h[i]*l5
+			}
+		}
+		s5 += f(x)
+	}
+
+	value := volume * (w7[0]*f0 + w7[1]*s2 + w7[2]*s3 + w7[3]*s4 + w7[4]*s5)
+	lower5 := volume * (w5[0]*f0 + w5[1]*s2 + w5[2]*s3 + w5[3]*s4)
+	return cubatureBox{
+		lower: lower,
+		upper: upper,
+		value: value,
+		err:   math.Abs(value - lower5),
+		split: split,
+	}
+}
+
+// IntegralTriangle calculates the integral of f over the triangle with vertices a, b and c with the Grundmann–Möller rule.
+// s: rule index, the rule is exact for polynomials of degree 2s+1
+func IntegralTriangle(f Func2D, a, b, c Point2D, s int) (float64, error) {
+	vertices := [][]float64{{a.X, a.Y}, {b.X, b.Y}, {c.X, c.Y}}
+	return IntegralSimplex(func(x []float64) float64 { return f(x[0], x[1]) }, vertices, s)
+}
+
+// IntegralSimplex calculates the integral of f over the d-dimensional simplex with d+1 vertices with the Grundmann–Möller rule.
+// The rule has negative weights, so large s may lose accuracy to cancellation.
+// vertices[d+1][d]: vertices of the simplex
+// s: rule index, the rule is exact for polynomials of degree 2s+1
+func IntegralSimplex(f func(x []float64) float64, vertices [][]float64, s int) (float64, error) {
+	d := len(vertices) - 1
+
+	// Check input
+	if d < 1 || s < 0 {
+		return 0, ErrWrongInput
+	}
+	for i := range vertices {
+		if len(vertices[i]) != d {
+			return 0, ErrWrongInput
+		}
+	}
+
+	// Volume factor |det(v_i - v_0)| = d!·volume
+	edges := make(Matrix, d)
+	for i := range d {
+		edges[i] = make([]float64, d)
+		for j := range d {
+			edges[i][j] = vertices[i+1][j] - vertices[0][j]
+		}
+	}
+	det, err := edges.Det()
+	if err != nil {
+		return 0, err
+	}
+	if det == 0 {
+		return 0, ErrWrongInput
+	}
+
+	degree := 2*s + 1
+	x := make([]float64, d)
+	beta := make([]int, d+1)
+	res := 0.
+	for i := 0; i <= s; i++ {
+		// A_i = (-1)^i 2^(-2s) (degree + d - 2i)^degree / (i! (degree + d - i)!)
+		denom := float64(degree + d - 2*i)
+		weight := math.Pow(2, -2*float64(s)) * math.Pow(denom, float64(degree))
+		weight /= math.Gamma(float64(i+1)) * math.Gamma(float64(degree+d-i+1))
+		if i%2 == 1 {
+			weight = -weight
+		}
+
+		// Sum over all β in N^(d+1) with |β| = s - i, barycentric coordinates (2β_j + 1) / denom
+		sum := 0.
+		compositions(beta, s-i, func(beta []int) {
+			for k := range x {
+				x[k] = 0
+			}
+			for j := range beta {
+				lambda := (2*float64(beta[j]) + 1) / denom
+				for k := range x {
+					x[k] += lambda * vertices[j][k]
+				}
+			}
+			sum += f(x)
+		})
+		res += weight * sum
+	}
+
+	return math.Abs(det) * res, nil
+}
+
+// compositions calls visit with every vector of len(beta) non-negative integers summing to total
+func compositions(beta []int, total int, visit func(beta []int)) {
+	if len(beta) == 1 {
+		beta[0] = total
+		visit(beta)
+		return
+	}
+	for k := 0; k <= total; k++ {
+		beta[0] = k
+		compositions(beta[1:], total-k, func([]int) { visit(beta) })
+	}
+}
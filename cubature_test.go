@@ -0,0 +1,158 @@
+package numericalanalysis_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	numericalanalysis "github.com/Russia9/numerical-analysis"
+)
+
+func TestIntegralBox(t *testing.T) {
+	t.Run("IntegralRectangle - bilinear interpolation field", func(t *testing.T) {
+		// f(x,y) = 1 + 2x + 3y + 4xy over [0,2] × [0,1]: 2 + 4 + 3 + 4 = 13
+		f := numericalanalysis.BilinearInterpolation2D([]numericalanalysis.Point3D{
+			{X: 0, Y: 0, Z: 1}, {X: 2, Y: 0, Z: 5},
+			{X: 0, Y: 1, Z: 4}, {X: 2, Y: 1, Z: 16},
+		})
+		res, err := numericalanalysis.IntegralRectangle(f, 0, 2, 0, 1, 2)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(res-13) > 1e-12 {
+			t.Errorf("result = %v, want 13", res)
+		}
+	})
+
+	t.Run("IntegralBox - separable function", func(t *testing.T) {
+		// ∫[0,1]^3 e^(x+y+z) = (e - 1)^3
+		f := func(x []float64) float64 { return math.Exp(x[0] + x[1] + x[2]) }
+		res, err := numericalanalysis.IntegralBox(f, []float64{0, 0, 0}, []float64{1, 1, 1}, 8)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if expected := math.Pow(math.E-1, 3); math.Abs(res-expected) > 1e-12 {
+			t.Errorf("result = %v, want ~%v", res, expected)
+		}
+	})
+
+	t.Run("input validation", func(t *testing.T) {
+		f := func(x []float64) float64 { return 1 }
+		if _, err := numericalanalysis.IntegralBox(f, []float64{0, 1}, []float64{1, 0}, 4); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+		if _, err := numericalanalysis.IntegralBox(f, []float64{0}, []float64{1}, 0); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+}
+
+func TestIntegralBoxAdaptive(t *testing.T) {
+	t.Run("exact for polynomials of degree 7", func(t *testing.T) {
+		// ∫[0,1]^2 x^4 y^3 + x^7 = 1/20 + 1/8
+		f := func(x []float64) float64 { return math.Pow(x[0], 4)*math.Pow(x[1], 3) + math.Pow(x[0], 7) }
+		res, err := numericalanalysis.IntegralBoxAdaptive(f, []float64{0, 0}, []float64{1, 1}, 1e-12, 0, 1000)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(res.Value-(1./20+1./8)) > 1e-13 {
+			t.Errorf("Value = %v, want %v", res.Value, 1./20+1./8)
+		}
+	})
+
+	t.Run("peak in three dimensions", func(t *testing.T) {
+		// ∫[-1,1]^3 e^(-10|x|²) = (√(π/10)·erf(√10))^3
+		f := func(x []float64) float64 { return math.Exp(-10 * (x[0]*x[0] + x[1]*x[1] + x[2]*x[2])) }
+		expected := math.Pow(math.Sqrt(math.Pi/10)*math.Erf(math.Sqrt(10)), 3)
+		res, err := numericalanalysis.IntegralBoxAdaptive(f, []float64{-1, -1, -1}, []float64{1, 1, 1}, 0, 1e-5, 10000)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		// The error estimate |I7 - I5| is pessimistic, the degree 7 result is much more accurate
+		if math.Abs(res.Value-expected) > 1e-6*expected {
+			t.Errorf("Value = %v, want ~%v (error estimate %v)", res.Value, expected, res.Error)
+		}
+		if res.Evals == 0 || res.Error > 1e-5*expected {
+			t.Errorf("Evals = %v, Error = %v", res.Evals, res.Error)
+		}
+	})
+
+	t.Run("one dimension", func(t *testing.T) {
+		f := func(x []float64) float64 { return math.Sin(x[0]) }
+		res, err := numericalanalysis.IntegralBoxAdaptive(f, []float64{0}, []float64{math.Pi}, 1e-12, 0, 100)
+		if err != nil || math.Abs(res.Value-2) > 1e-12 {
+			t.Errorf("result = %+v, err = %v, want 2", res, err)
+		}
+	})
+
+	t.Run("subdivision limit", func(t *testing.T) {
+		f := func(x []float64) float64 { return math.Sqrt(math.Abs(x[0] - x[1])) }
+		res, err := numericalanalysis.IntegralBoxAdaptive(f, []float64{0, 0}, []float64{1, 1}, 1e-14, 0, 5)
+		if !errors.Is(err, numericalanalysis.ErrDidNotConverge) {
+			t.Errorf("err = %v, want ErrDidNotConverge", err)
+		}
+		if res.Evals == 0 {
+			t.Errorf("result = %+v, want partial estimate", res)
+		}
+	})
+}
+
+func TestIntegralSimplex(t *testing.T) {
+	t.Run("IntegralTriangle - area", func(t *testing.T) {
+		f := func(x, y float64) float64 { return 1 }
+		res, err := numericalanalysis.IntegralTriangle(f, numericalanalysis.Point2D{X: 1, Y: 1}, numericalanalysis.Point2D{X: 4, Y: 1}, numericalanalysis.Point2D{X: 1, Y: 3}, 0)
+		if err != nil || math.Abs(res-3) > 1e-14 {
+			t.Errorf("result = %v, err = %v, want 3", res, err)
+		}
+	})
+
+	t.Run("IntegralTriangle - exact for polynomials of degree 2s+1", func(t *testing.T) {
+		// ∫ over the unit triangle x^a y^b = a! b! / (a + b + 2)!
+		a, b := numericalanalysis.Point2D{X: 0, Y: 0}, numericalanalysis.Point2D{X: 1, Y: 0}
+		c := numericalanalysis.Point2D{X: 0, Y: 1}
+		f := func(x, y float64) float64 { return math.Pow(x, 3) * math.Pow(y, 4) }
+		res, err := numericalanalysis.IntegralTriangle(f, a, b, c, 3)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if expected := 6. * 24 / 362880; math.Abs(res-expected) > 1e-15 {
+			t.Errorf("result = %v, want %v", res, expected)
+		}
+	})
+
+	t.Run("IntegralSimplex - tetrahedron", func(t *testing.T) {
+		// ∫ over the unit tetrahedron x y z = 1/720
+		vertices := [][]float64{{0, 0, 0}, {1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+		f := func(x []float64) float64 { return x[0] * x[1] * x[2] }
+		res, err := numericalanalysis.IntegralSimplex(f, vertices, 1)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(res-1./720) > 1e-15 {
+			t.Errorf("result = %v, want %v", res, 1./720)
+		}
+	})
+
+	t.Run("IntegralSimplex - smooth function converges", func(t *testing.T) {
+		// ∫ over the unit triangle e^(x+y) = 1
+		vertices := [][]float64{{0, 0}, {1, 0}, {0, 1}}
+		f := func(x []float64) float64 { return math.Exp(x[0] + x[1]) }
+		res, err := numericalanalysis.IntegralSimplex(f, vertices, 5)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(res-1) > 1e-10 {
+			t.Errorf("result = %v, want ~1", res)
+		}
+	})
+
+	t.Run("input validation", func(t *testing.T) {
+		f := func(x []float64) float64 { return 1 }
+		if _, err := numericalanalysis.IntegralSimplex(f, [][]float64{{0, 0}, {1, 1}, {2, 2}}, 1); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("degenerate: err = %v, want ErrWrongInput", err)
+		}
+		if _, err := numericalanalysis.IntegralSimplex(f, [][]float64{{0, 0}, {1, 0}}, 1); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("too few vertices: err = %v, want ErrWrongInput", err)
+		}
+	})
+}
@@ -0,0 +1,477 @@
+package numericalanalysis
+
+import (
+	"context"
+	"math"
+)
+
+// descent.go
+// Gradient-based unconstrained minimizers
+
+// Wolfe conditions constants
+const (
+	wolfeC1     = 1e-4 // sufficient decrease
+	wolfeC2     = 0.9  // curvature condition for quasi-Newton methods
+	wolfeC2CG   = 0.1  // curvature condition for conjugate gradient methods
+	wolfeMaxIts = 50   // maximum number of trial steps in a line search
+)
+
+// BFGSExtremum method for finding a minimum of a function of many variables
+// f: function to minimize
+// grad: gradient of f; if nil, central differences with deltaX steps are used
+// x0: initial guess for the solution
+// deltaX: step size for each variable (for differential calculations, ignored if grad is set)
+// eps: tolerance for the gradient norm
+// maxIter: maximum number of iterations
+func BFGSExtremum(f func(x []float64) float64, grad func(x []float64) []float64, x0 []float64, deltaX []float64, eps float64, maxIter int) ([]float64, error) {
+	return BFGSExtremumContext(context.Background(), 0, f, grad, x0, deltaX, eps, maxIter)
+}
+
+// BFGSExtremumContext is BFGSExtremum that stops on ctx cancellation or after maxEvals evaluations of f (0 = unlimited)
+func BFGSExtremumContext(ctx context.Context, maxEvals int, f func(x []float64) float64, grad func(x []float64) []float64, x0 []float64, deltaX []float64, eps float64, maxIter int) ([]float64, error) {
+	n := len(x0)
+
+	// Check input
+	if n == 0 || eps <= 0 || maxIter <= 0 {
+		return nil, ErrWrongInput
+	}
+	budget, err := newEvalBudget(ctx, maxEvals)
+	if err != nil {
+		return nil, err
+	}
+	f = budget.funcVec(f)
+	grad, err = gradientOrDifferences(f, grad, deltaX, n)
+	if err != nil {
+		return nil, err
+	}
+
+	x := make([]float64, n)
+	copy(x, x0)
+	fx := f(x)
+	g := grad(x)
+
+	// Inverse Hessian approximation, scaled by the first accepted update after initialization or a reset
+	H := IdentityMatrix(n)
+	scale := true
+
+	for k := 0; k < maxIter; k++ {
+		// Check cancellation and budget
+		if err := budget.check(); err != nil {
+			return nil, err
+		}
+
+		if Norm(g) < eps {
+			return x, nil
+		}
+
+		// Search direction d = -H * g
+		d := make([]float64, n)
+		for i := range n {
+			for j := range n {
+				d[i] -= H[i][j] * g[j]
+			}
+		}
+		if dot(d, g) >= 0 { // H lost positive definiteness, reset it
+			H = IdentityMatrix(n)
+			scale = true
+			for i := range d {
+				d[i] = -g[i]
+			}
+		}
+
+		alpha, x1, fx1, g1, ok := wolfeLineSearch(f, grad, x, d, fx, g, 1, wolfeC2)
+		if err := budget.check(); err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, ErrDidNotConverge
+		}
+
+		s := make([]float64, n)
+		y := make([]float64, n)
+		for i := range n {
+			s[i] = alpha * d[i]
+			y[i] = g1[i] - g[i]
+		}
+		x, fx, g = x1, fx1, g1
+
+		sy := dot(s, y)
+		if sy <= 1e-12*Norm(s)*Norm(y) { // Curvature condition failed, skip update
+			continue
+		}
+
+		// Scale the identity before its first update
+		if scale {
+			H = IdentityMatrix(n).MulNumber(sy / dot(y, y))
+			scale = false
+		}
+
+		// H = (I - ρ s yᵀ) H (I - ρ y sᵀ) + ρ s sᵀ
+		rho := 1 / sy
+		Hy := make([]float64, n)
+		for i := range n {
+			for j := range n {
+				Hy[i] += H[i][j] * y[j]
+			}
+		}
+		yHy := dot(y, Hy)
+		for i := range n {
+			for j := range n {
+				H[i][j] += -rho*(Hy[i]*s[j]+s[i]*Hy[j]) + (rho*rho*yHy+rho)*s[i]*s[j]
+			}
+		}
+	}
+
+	if Norm(g) < eps {
+		return x, nil
+	}
+	return nil, ErrDidNotConverge
+}
+
+// LBFGSExtremum method (limited-memory BFGS) for finding a minimum of a function of many variables
+// f: function to minimize
+// grad: gradient of f; if nil, central differences with deltaX steps are used
+// x0: initial guess for the solution
+// deltaX: step size for each variable (for differential calculations, ignored if grad is set)
+// m: number of stored correction pairs
+// eps: tolerance for the gradient norm
+// maxIter: maximum number of iterations
+func LBFGSExtremum(f func(x []float64) float64, grad func(x []float64) []float64, x0 []float64, deltaX []float64, m int, eps float64, maxIter int) ([]float64, error) {
+	return LBFGSExtremumContext(context.Background(), 0, f, grad, x0, deltaX, m, eps, maxIter)
+}
+
+// LBFGSExtremumContext is LBFGSExtremum that stops on ctx cancellation or after maxEvals evaluations of f (0 = unlimited)
+func LBFGSExtremumContext(ctx context.Context, maxEvals int, f func(x []float64) float64, grad func(x []float64) []float64, x0 []float64, deltaX []float64, m int, eps float64, maxIter int) ([]float64, error) {
+	n := len(x0)
+
+	// Check input
+	if n == 0 || m <= 0 || eps <= 0 || maxIter <= 0 {
+		return nil, ErrWrongInput
+	}
+	budget, err := newEvalBudget(ctx, maxEvals)
+	if err != nil {
+		return nil, err
+	}
+	f = budget.funcVec(f)
+	grad, err = gradientOrDifferences(f, grad, deltaX, n)
+	if err != nil {
+		return nil, err
+	}
+
+	x := make([]float64, n)
+	copy(x, x0)
+	fx := f(x)
+	g := grad(x)
+
+	// Correction pairs history
+	var sHist, yHist [][]float64
+	var rhoHist []float64
+
+	for k := 0; k < maxIter; k++ {
+		// Check cancellation and budget
+		if err := budget.check(); err != nil {
+			return nil, err
+		}
+
+		if Norm(g) < eps {
+			return x, nil
+		}
+
+		// Two-loop recursion: d = -H * g
+		q := make([]float64, n)
+		copy(q, g)
+		a := make([]float64, len(sHist))
+		for i := len(sHist) - 1; i >= 0; i-- {
+			a[i] = rhoHist[i] * dot(sHist[i], q)
+			for j := range q {
+				q[j] -= a[i] * yHist[i][j]
+			}
+		}
+		gamma := 1.0
+		if l := len(sHist); l > 0 {
+			gamma = dot(sHist[l-1], yHist[l-1]) / dot(yHist[l-1], yHist[l-1])
+		}
+		for j := range q {
+			q[j] *= gamma
+		}
+		for i := range sHist {
+			b := rhoHist[i] * dot(yHist[i], q)
+			for j := range q {
+				q[j] += sHist[i][j] * (a[i] - b)
+			}
+		}
+		d := q
+		for j := range d {
+			d[j] = -d[j]
+		}
+		if dot(d, g) >= 0 { // Not a descent direction, drop the history
+			sHist, yHist, rhoHist = nil, nil, nil
+			for j := range d {
+				d[j] = -g[j]
+			}
+		}
+
+		alpha, x1, fx1, g1, ok := wolfeLineSearch(f, grad, x, d, fx, g, 1, wolfeC2)
+		if err := budget.check(); err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, ErrDidNotConverge
+		}
+
+		s := make([]float64, n)
+		y := make([]float64, n)
+		for i := range n {
+			s[i] = alpha * d[i]
+			y[i] = g1[i] - g[i]
+		}
+		x, fx, g = x1, fx1, g1
+
+		sy := dot(s, y)
+		if sy <= 1e-12*Norm(s)*Norm(y) { // Curvature condition failed, skip update
+			continue
+		}
+		if len(sHist) == m {
+			sHist, yHist, rhoHist = sHist[1:], yHist[1:], rhoHist[1:]
+		}
+		sHist = append(sHist, s)
+		yHist = append(yHist, y)
+		rhoHist = append(rhoHist, 1/sy)
+	}
+
+	if Norm(g) < eps {
+		return x, nil
+	}
+	return nil, ErrDidNotConverge
+}
+
+// ConjugateGradientExtremum method (Polak–Ribière) for finding a minimum of a function of many variables
+// f: function to minimize
+// grad: gradient of f; if nil, central differences with deltaX steps are used
+// x0: initial guess for the solution
+// deltaX: step size for each variable (for differential calculations, ignored if grad is set)
+// eps: tolerance for the gradient norm
+// maxIter: maximum number of iterations
+func ConjugateGradientExtremum(f func(x []float64) float64, grad func(x []float64) []float64, x0 []float64, deltaX []float64, eps float64, maxIter int) ([]float64, error) {
+	return ConjugateGradientExtremumContext(context.Background(), 0, f, grad, x0, deltaX, eps, maxIter)
+}
+
+// ConjugateGradientExtremumContext is ConjugateGradientExtremum that stops on ctx cancellation or after maxEvals evaluations of f (0 = unlimited)
+func ConjugateGradientExtremumContext(ctx context.Context, maxEvals int, f func(x []float64) float64, grad func(x []float64) []float64, x0 []float64, deltaX []float64, eps float64, maxIter int) ([]float64, error) {
+	n := len(x0)
+
+	// Check input
+	if n == 0 || eps <= 0 || maxIter <= 0 {
+		return nil, ErrWrongInput
+	}
+	budget, err := newEvalBudget(ctx, maxEvals)
+	if err != nil {
+		return nil, err
+	}
+	f = budget.funcVec(f)
+	grad, err = gradientOrDifferences(f, grad, deltaX, n)
+	if err != nil {
+		return nil, err
+	}
+
+	x := make([]float64, n)
+	copy(x, x0)
+	fx := f(x)
+	g := grad(x)
+
+	// Start with the steepest descent direction
+	d := make([]float64, n)
+	for i := range g {
+		d[i] = -g[i]
+	}
+	alpha := 1 / math.Max(Norm(g), 1)
+
+	for k := 0; k < maxIter; k++ {
+		// Check cancellation and budget
+		if err := budget.check(); err != nil {
+			return nil, err
+		}
+
+		if Norm(g) < eps {
+			return x, nil
+		}
+
+		dg := dot(d, g)
+		a, x1, fx1, g1, ok := wolfeLineSearch(f, grad, x, d, fx, g, alpha, wolfeC2CG)
+		if err := budget.check(); err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, ErrDidNotConverge
+		}
+
+		// β = max(0, g1ᵀ(g1 - g) / gᵀg)
+		beta := 0.
+		gg := dot(g, g)
+		for i := range g {
+			beta += g1[i] * (g1[i] - g[i])
+		}
+		beta = math.Max(beta/gg, 0)
+		if (k+1)%n == 0 { // Periodic restart
+			beta = 0
+		}
+
+		x, fx, g = x1, fx1, g1
+		for i := range d {
+			d[i] = -g[i] + beta*d[i]
+		}
+
+		// Restart if the new direction is not a descent direction
+		dg1 := dot(d, g)
+		if dg1 >= 0 {
+			for i := range d {
+				d[i] = -g[i]
+			}
+			dg1 = -dot(g, g)
+		}
+
+		// Initial step for the next line search keeps the first-order change the same
+		alpha = math.Min(a*dg/dg1, 1e10)
+	}
+
+	if Norm(g) < eps {
+		return x, nil
+	}
+	return nil, ErrDidNotConverge
+}
+
+// gradientOrDifferences returns grad or, if it is nil, a central differences gradient of f
+func gradientOrDifferences(f func(x []float64) float64, grad func(x []float64) []float64, deltaX []float64, n int) (func(x []float64) []float64, error) {
+	if grad != nil {
+		return grad, nil
+	}
+
+	// Check steps
+	if len(deltaX) != n {
+		return nil, ErrWrongInput
+	}
+	for i := range deltaX {
+		if deltaX[i] <= 0 {
+			return nil, ErrWrongInput
+		}
+	}
+
+	return func(x []float64) []float64 {
+		g, _ := Gradient(f, x, deltaX, CentralDifference)
+		return g
+	}, nil
+}
+
+// wolfeLineSearch finds a step along the descent direction d that satisfies the strong Wolfe conditions.
+// Returns the step, the new point, the function value and the gradient at it.
+func wolfeLineSearch(
+	f func(x []float64) float64,
+	grad func(x []float64) []float64,
+	x, d []float64,
+	fx float64,
+	gx []float64,
+	alphaInit float64,
+	c2 float64,
+) (float64, []float64, float64, []float64, bool) {
+	type trial struct {
+		alpha float64
+		x     []float64
+		f     float64
+		g     []float64
+		d     float64 // directional derivative
+	}
+
+	// Evaluate φ(α) = f(x + α d)
+	eval := func(alpha float64) trial {
+		xa := make([]float64, len(x))
+		for i := range x {
+			xa[i] = x[i] + alpha*d[i]
+		}
+		ga := grad(xa)
+		return trial{alpha: alpha, x: xa, f: f(xa), g: ga, d: dot(ga, d)}
+	}
+
+	dphi0 := dot(gx, d)
+	if dphi0 >= 0 {
+		return 0, nil, 0, nil, false
+	}
+
+	// Refine the bracket [lo, hi] until a step satisfies the Wolfe conditions
+	zoom := func(lo, hi trial) (trial, bool) {
+		for range wolfeMaxIts {
+			// Cubic interpolation, safeguarded by bisection
+			a := cubicMinimizer(lo.alpha, lo.f, lo.d, hi.alpha, hi.f, hi.d)
+			left, right := math.Min(lo.alpha, hi.alpha), math.Max(lo.alpha, hi.alpha)
+			margin := 0.1 * (right - left)
+			if math.IsNaN(a) || a < left+margin || a > right-margin {
+				a = (lo.alpha + hi.alpha) / 2
+			}
+			if right-left < 1e-14*math.Max(right, 1) {
+				break
+			}
+
+			t := eval(a)
+			if t.f > fx+wolfeC1*a*dphi0 || t.f >= lo.f {
+				hi = t
+			} else {
+				if math.Abs(t.d) <= -c2*dphi0 {
+					return t, true
+				}
+				if t.d*(hi.alpha-lo.alpha) >= 0 {
+					hi = lo
+				}
+				lo = t
+			}
+		}
+
+		// Accept the best point found if it gives a sufficient decrease
+		return lo, lo.alpha > 0 && lo.f <= fx+wolfeC1*lo.alpha*dphi0
+	}
+
+	prev := trial{alpha: 0, x: x, f: fx, g: gx, d: dphi0}
+	alpha := alphaInit
+	for i := range wolfeMaxIts {
+		t := eval(alpha)
+		if math.IsNaN(t.f) || math.IsInf(t.f, 0) { // Step is too long, shrink it
+			alpha = (prev.alpha + alpha) / 2
+			continue
+		}
+
+		var res trial
+		var ok bool
+		switch {
+		case t.f > fx+wolfeC1*alpha*dphi0 || (i > 0 && t.f >= prev.f):
+			res, ok = zoom(prev, t)
+		case math.Abs(t.d) <= -c2*dphi0:
+			res, ok = t, true
+		case t.d >= 0:
+			res, ok = zoom(t, prev)
+		default:
+			prev = t
+			alpha *= 2
+			continue
+		}
+		return res.alpha, res.x, res.f, res.g, ok
+	}
+
+	return 0, nil, 0, nil, false
+}
+
+// cubicMinimizer returns the minimizer of the cubic interpolating φ and φ' at a and b
+func cubicMinimizer(a, fa, da, b, fb, db float64) float64 {
+	d1 := da + db - 3*(fa-fb)/(a-b)
+	d2 := d1*d1 - da*db
+	if d2 < 0 {
+		return math.NaN()
+	}
+	d2 = math.Copysign(math.Sqrt(d2), b-a)
+	return b - (b-a)*(db+d2-d1)/(db-da+2*d2)
+}
+
+func dot(a, b []float64) float64 {
+	var sum float64
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
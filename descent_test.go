@@ -0,0 +1,132 @@
+package numericalanalysis_test
+
+import (
+	"math"
+	"testing"
+
+	numericalanalysis "github.com/Russia9/numerical-analysis"
+)
+
+type minimizer func(f func(x []float64) float64, grad func(x []float64) []float64, x0 []float64, deltaX []float64, eps float64, maxIter int) ([]float64, error)
+
+var minimizers = map[string]minimizer{
+	"BFGS": numericalanalysis.BFGSExtremum,
+	"LBFGS": func(f func(x []float64) float64, grad func(x []float64) []float64, x0 []float64, deltaX []float64, eps float64, maxIter int) ([]float64, error) {
+		return numericalanalysis.LBFGSExtremum(f, grad, x0, deltaX, 5, eps, maxIter)
+	},
+	"ConjugateGradient": numericalanalysis.ConjugateGradientExtremum,
+}
+
+func rosenbrock(x []float64) float64 {
+	return (1-x[0])*(1-x[0]) + 100*(x[1]-x[0]*x[0])*(x[1]-x[0]*x[0])
+}
+
+func rosenbrockGrad(x []float64) []float64 {
+	return []float64{
+		-2*(1-x[0]) - 400*x[0]*(x[1]-x[0]*x[0]),
+		200 * (x[1] - x[0]*x[0]),
+	}
+}
+
+func TestGradientMinimizers(t *testing.T) {
+	for name, minimize := range minimizers {
+		t.Run(name+" - input validation - empty initial guess", func(t *testing.T) {
+			_, err := minimize(rosenbrock, rosenbrockGrad, []float64{}, nil, 1e-6, 100)
+			if err != numericalanalysis.ErrWrongInput {
+				t.Errorf("err = %v, want ErrWrongInput", err)
+			}
+		})
+
+		t.Run(name+" - input validation - missing steps", func(t *testing.T) {
+			_, err := minimize(rosenbrock, nil, []float64{0, 0}, []float64{1e-6}, 1e-6, 100)
+			if err != numericalanalysis.ErrWrongInput {
+				t.Errorf("err = %v, want ErrWrongInput", err)
+			}
+		})
+
+		t.Run(name+" - input validation - non-positive tolerance", func(t *testing.T) {
+			_, err := minimize(rosenbrock, rosenbrockGrad, []float64{0, 0}, nil, 0, 100)
+			if err != numericalanalysis.ErrWrongInput {
+				t.Errorf("err = %v, want ErrWrongInput", err)
+			}
+		})
+
+		t.Run(name+" - shifted paraboloid", func(t *testing.T) {
+			// f(x,y) = (x-1)^2 + 10(y+2)^2, minimum at (1, -2)
+			f := func(x []float64) float64 {
+				return (x[0]-1)*(x[0]-1) + 10*(x[1]+2)*(x[1]+2)
+			}
+
+			result, err := minimize(f, nil, []float64{5, 5}, []float64{1e-5, 1e-5}, 1e-6, 1000)
+			if err != nil {
+				t.Fatalf("err = %v, want nil", err)
+			}
+			if math.Abs(result[0]-1) > 1e-5 || math.Abs(result[1]+2) > 1e-5 {
+				t.Errorf("result = %v, want ~[1 -2]", result)
+			}
+		})
+
+		t.Run(name+" - rosenbrock function with analytic gradient", func(t *testing.T) {
+			result, err := minimize(rosenbrock, rosenbrockGrad, []float64{-1.2, 1}, nil, 1e-8, 10000)
+			if err != nil {
+				t.Fatalf("err = %v, want nil", err)
+			}
+			if math.Abs(result[0]-1) > 1e-6 || math.Abs(result[1]-1) > 1e-6 {
+				t.Errorf("result = %v, want ~[1 1]", result)
+			}
+		})
+
+		t.Run(name+" - rosenbrock function with finite differences", func(t *testing.T) {
+			result, err := minimize(rosenbrock, nil, []float64{-1.2, 1}, []float64{1e-6, 1e-6}, 1e-5, 10000)
+			if err != nil {
+				t.Fatalf("err = %v, want nil", err)
+			}
+			if math.Abs(result[0]-1) > 1e-3 || math.Abs(result[1]-1) > 1e-3 {
+				t.Errorf("result = %v, want ~[1 1]", result)
+			}
+		})
+
+		t.Run(name+" - high-dimensional quadratic", func(t *testing.T) {
+			// f(x) = Σ i (x_i - 1)^2, minimum at (1, ..., 1)
+			n := 200
+			f := func(x []float64) float64 {
+				sum := 0.
+				for i := range x {
+					sum += float64(i+1) * (x[i] - 1) * (x[i] - 1)
+				}
+				return sum
+			}
+			grad := func(x []float64) []float64 {
+				g := make([]float64, len(x))
+				for i := range x {
+					g[i] = 2 * float64(i+1) * (x[i] - 1)
+				}
+				return g
+			}
+
+			result, err := minimize(f, grad, make([]float64, n), nil, 1e-8, 10000)
+			if err != nil {
+				t.Fatalf("err = %v, want nil", err)
+			}
+			for i := range result {
+				if math.Abs(result[i]-1) > 1e-6 {
+					t.Fatalf("result[%d] = %v, want ~1", i, result[i])
+				}
+			}
+		})
+
+		t.Run(name+" - iteration limit", func(t *testing.T) {
+			_, err := minimize(rosenbrock, rosenbrockGrad, []float64{-1.2, 1}, nil, 1e-12, 2)
+			if err != numericalanalysis.ErrDidNotConverge {
+				t.Errorf("err = %v, want ErrDidNotConverge", err)
+			}
+		})
+	}
+
+	t.Run("LBFGS - input validation - empty history", func(t *testing.T) {
+		_, err := numericalanalysis.LBFGSExtremum(rosenbrock, rosenbrockGrad, []float64{0, 0}, nil, 0, 1e-6, 100)
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+}
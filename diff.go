@@ -0,0 +1,306 @@
+package numericalanalysis
+
+import (
+	"math"
+	"sync"
+)
+
+// diff.go
+// Numerical differentiation
+
+// DifferenceScheme is a finite difference formula for the first derivative
+type DifferenceScheme int
+
+const (
+	ForwardDifference   DifferenceScheme = iota // (f(x+h) - f(x)) / h, error O(h)
+	CentralDifference                           // (f(x+h) - f(x-h)) / 2h, error O(h²)
+	FivePointDifference                         // (-f(x+2h) + 8f(x+h) - 8f(x-h) + f(x-2h)) / 12h, error O(h⁴)
+)
+
+// Derivative calculates the first derivative of f at x with the given step and scheme.
+// Returns NaN for an unknown scheme.
+func Derivative(f Func1D, x, h float64, scheme DifferenceScheme) float64 {
+	switch scheme {
+	case ForwardDifference:
+		return (f(x+h) - f(x)) / h
+	case CentralDifference:
+		return (f(x+h) - f(x-h)) / (2 * h)
+	case FivePointDifference:
+		return (-f(x+2*h) + 8*f(x+h) - 8*f(x-h) + f(x-2*h)) / (12 * h)
+	}
+	return math.NaN()
+}
+
+// DerivativeStep returns a step for the scheme that balances truncation and rounding errors at x.
+// Returns NaN for an unknown scheme.
+func DerivativeStep(x float64, scheme DifferenceScheme) float64 {
+	// h = ε^(1/(p+1)) · max(|x|, 1) for a scheme of order p
+	var order float64
+	switch scheme {
+	case ForwardDifference:
+		order = 1
+	case CentralDifference:
+		order = 2
+	case FivePointDifference:
+		order = 4
+	default:
+		return math.NaN()
+	}
+	return math.Pow(epsilon, 1/(order+1)) * math.Max(math.Abs(x), 1)
+}
+
+// epsilon is the machine epsilon for float64
+const epsilon = 2.220446049250313e-16
+
+// DerivativeRichardson calculates the first derivative of f at x by Richardson extrapolation of central differences.
+// Returns the derivative and an estimate of its error.
+// h: initial step, halved on every level
+// levels: maximum number of extrapolation levels
+func DerivativeRichardson(f Func1D, x, h float64, levels int) (float64, float64, error) {
+	// Check input
+	if h <= 0 || levels < 1 {
+		return 0, 0, ErrWrongInput
+	}
+
+	// Richardson table T[i][j], T[i][0] is the central difference with step h/2^i
+	T := make([][]float64, levels+1)
+	T[0] = []float64{Derivative(f, x, h, CentralDifference)}
+	best, bestErr := T[0][0], math.Inf(1)
+
+	for i := 1; i <= levels; i++ {
+		h /= 2
+		T[i] = make([]float64, i+1)
+		T[i][0] = Derivative(f, x, h, CentralDifference)
+
+		factor := 1.
+		for j := 1; j <= i; j++ {
+			factor *= 4
+			T[i][j] = T[i][j-1] + (T[i][j-1]-T[i-1][j-1])/(factor-1)
+
+			// Error estimate: distance to the neighbours in the table
+			e := math.Max(math.Abs(T[i][j]-T[i][j-1]), math.Abs(T[i][j]-T[i-1][j-1]))
+			if e <= bestErr {
+				best, bestErr = T[i][j], e
+			}
+		}
+
+		// Stop when rounding errors start to dominate
+		if math.Abs(T[i][i]-T[i-1][i-1]) >= 2*bestErr {
+			break
+		}
+	}
+
+	return best, bestErr, nil
+}
+
+// Gradient calculates the gradient of f at x
+// h[n]: step for each variable; if nil, DerivativeStep is used
+func Gradient(f func(x []float64) float64, x []float64, h []float64, scheme DifferenceScheme) ([]float64, error) {
+	return GradientParallel(f, x, h, scheme, 1)
+}
+
+// GradientParallel is Gradient that evaluates the stencil points on up to workers goroutines.
+// f must be safe for concurrent use if workers > 1. The result does not depend on the number of workers.
+func GradientParallel(f func(x []float64) float64, x []float64, h []float64, scheme DifferenceScheme, workers int) ([]float64, error) {
+	n := len(x)
+
+	// Check input
+	if workers < 1 {
+		return nil, ErrWrongInput
+	}
+	h, err := differenceSteps(x, h, scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stencil offsets (in steps) and weights of the scheme
+	offsets, weights, denom := []float64{1, -1}, []float64{1, -1}, 2.
+	switch scheme {
+	case ForwardDifference:
+		offsets, weights, denom = []float64{1}, []float64{1}, 1
+	case FivePointDifference:
+		offsets, weights, denom = []float64{2, 1, -1, -2}, []float64{-1, 8, -8, 1}, 12
+	}
+
+	// Points x + offset·h_i·e_i for every variable, followed by x itself for forward differences
+	points := make([][]float64, 0, n*len(offsets)+1)
+	for i := range n {
+		for _, o := range offsets {
+			points = append(points, shifted(x, i, o*h[i]))
+		}
+	}
+	if scheme == ForwardDifference {
+		points = append(points, x)
+	}
+	values := evaluatePoints(f, points, workers)
+
+	grad := make([]float64, n)
+	for i := range n {
+		sum := 0.
+		for k, w := range weights {
+			sum += w * values[i*len(offsets)+k]
+		}
+		if scheme == ForwardDifference {
+			sum -= values[len(values)-1]
+		}
+		grad[i] = sum / (denom * h[i])
+	}
+
+	return grad, nil
+}
+
+// Jacobian calculates the Jacobian matrix J[i][j] = ∂f_i/∂x_j of a system of functions at x
+// h[n]: step for each variable; if nil, DerivativeStep is used
+func Jacobian(f []func(x []float64) float64, x []float64, h []float64, scheme DifferenceScheme) (Matrix, error) {
+	// Check input
+	if len(f) == 0 {
+		return nil, ErrWrongInput
+	}
+	h, err := differenceSteps(x, h, scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	J := make(Matrix, len(f))
+	for i := range f {
+		J[i] = make([]float64, len(x))
+		for j := range x {
+			J[i][j] = Derivative(partial(f[i], x, j), x[j], h[j], scheme)
+		}
+	}
+
+	return J, nil
+}
+
+// Hessian calculates the Hessian matrix of f at x with central differences
+// h[n]: step for each variable; if nil, a step suited for second derivatives is used
+func Hessian(f func(x []float64) float64, x []float64, h []float64) (Matrix, error) {
+	return HessianParallel(f, x, h, 1)
+}
+
+// HessianParallel is Hessian that evaluates the 2n² + 1 stencil points on up to workers goroutines.
+// f must be safe for concurrent use if workers > 1. The result does not depend on the number of workers.
+func HessianParallel(f func(x []float64) float64, x []float64, h []float64, workers int) (Matrix, error) {
+	n := len(x)
+
+	// Check input
+	if n == 0 || workers < 1 || (h != nil && len(h) != n) {
+		return nil, ErrWrongInput
+	}
+	if h == nil {
+		h = make([]float64, n)
+		for i := range x {
+			h[i] = math.Pow(epsilon, 0.25) * math.Max(math.Abs(x[i]), 1)
+		}
+	}
+	for i := range h {
+		if h[i] <= 0 {
+			return nil, ErrWrongInput
+		}
+	}
+
+	// Stencil points: x, then x ± h_i for every i, then x ± h_i ± h_j for every i < j
+	points := [][]float64{x}
+	for i := range n {
+		points = append(points, shifted(x, i, h[i]), shifted(x, i, -h[i]))
+	}
+	signs := [4][2]float64{{1, 1}, {1, -1}, {-1, 1}, {-1, -1}}
+	for i := range n {
+		for j := i + 1; j < n; j++ {
+			for _, s := range signs {
+				y := shifted(x, i, s[0]*h[i])
+				y[j] += s[1] * h[j]
+				points = append(points, y)
+			}
+		}
+	}
+	values := evaluatePoints(f, points, workers)
+
+	fx := values[0]
+	H := make(Matrix, n)
+	for i := range n {
+		H[i] = make([]float64, n)
+	}
+	k := 1 + 2*n
+	for i := range n {
+		// diagonal
+		H[i][i] = (values[1+2*i] - 2*fx + values[2+2*i]) / (h[i] * h[i])
+
+		// off-diagonals
+		for j := i + 1; j < n; j++ {
+			val := (values[k] - values[k+1] - values[k+2] + values[k+3]) / (4 * h[i] * h[j])
+			H[i][j] = val
+			H[j][i] = val
+			k += 4
+		}
+	}
+
+	return H, nil
+}
+
+// evaluatePoints evaluates f at every point on up to workers goroutines, the values keep the order of the points
+func evaluatePoints(f func(x []float64) float64, points [][]float64, workers int) []float64 {
+	values := make([]float64, len(points))
+	if workers <= 1 {
+		for k := range points {
+			values[k] = f(points[k])
+		}
+		return values
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(workers, len(points)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := range jobs {
+				values[k] = f(points[k])
+			}
+		}()
+	}
+	for k := range points {
+		jobs <- k
+	}
+	close(jobs)
+	wg.Wait()
+
+	return values
+}
+
+// differenceSteps validates the scheme and the steps or, if they are nil, selects them automatically
+func differenceSteps(x, h []float64, scheme DifferenceScheme) ([]float64, error) {
+	if len(x) == 0 || (h != nil && len(h) != len(x)) || scheme < ForwardDifference || scheme > FivePointDifference {
+		return nil, ErrWrongInput
+	}
+	if h == nil {
+		h = make([]float64, len(x))
+		for i := range x {
+			h[i] = DerivativeStep(x[i], scheme)
+		}
+	}
+	for i := range h {
+		if h[i] <= 0 {
+			return nil, ErrWrongInput
+		}
+	}
+	return h, nil
+}
+
+// shifted returns a copy of x with d added to the i-th variable
+func shifted(x []float64, i int, d float64) []float64 {
+	y := make([]float64, len(x))
+	copy(y, x)
+	y[i] += d
+	return y
+}
+
+// partial returns f as a function of the i-th variable with the others fixed at x
+func partial(f func(x []float64) float64, x []float64, i int) Func1D {
+	return func(t float64) float64 {
+		y := make([]float64, len(x))
+		copy(y, x)
+		y[i] = t
+		return f(y)
+	}
+}
@@ -0,0 +1,241 @@
+package numericalanalysis_test
+
+import (
+	"math"
+	"testing"
+
+	numericalanalysis "github.com/Russia9/numerical-analysis"
+)
+
+func TestDerivative(t *testing.T) {
+	tests := map[string]struct {
+		scheme numericalanalysis.DifferenceScheme
+		h      float64
+		tol    float64
+	}{
+		"forward":    {scheme: numericalanalysis.ForwardDifference, h: 1e-6, tol: 1e-5},
+		"central":    {scheme: numericalanalysis.CentralDifference, h: 1e-4, tol: 1e-8},
+		"five-point": {scheme: numericalanalysis.FivePointDifference, h: 1e-2, tol: 1e-9},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// (e^x)' = e^x
+			d := numericalanalysis.Derivative(math.Exp, 1, test.h, test.scheme)
+			if math.Abs(d-math.E) > test.tol {
+				t.Errorf("Derivative = %v, want ~%v", d, math.E)
+			}
+		})
+
+		t.Run(name+" - automatic step", func(t *testing.T) {
+			h := numericalanalysis.DerivativeStep(2, test.scheme)
+			d := numericalanalysis.Derivative(math.Sin, 2, h, test.scheme)
+			if math.Abs(d-math.Cos(2)) > 1e-7 {
+				t.Errorf("Derivative = %v, want ~%v", d, math.Cos(2))
+			}
+		})
+	}
+
+	t.Run("five-point is exact for quartics", func(t *testing.T) {
+		f := func(x float64) float64 { return x*x*x*x - 2*x*x*x + x }
+		d := numericalanalysis.Derivative(f, 1.5, 0.1, numericalanalysis.FivePointDifference)
+		expected := 4*1.5*1.5*1.5 - 6*1.5*1.5 + 1
+		if math.Abs(d-expected) > 1e-12 {
+			t.Errorf("Derivative = %v, want %v", d, expected)
+		}
+	})
+
+	t.Run("unknown scheme", func(t *testing.T) {
+		if d := numericalanalysis.Derivative(math.Exp, 1, 1e-4, 3); !math.IsNaN(d) {
+			t.Errorf("Derivative = %v, want NaN", d)
+		}
+		if h := numericalanalysis.DerivativeStep(1, 3); !math.IsNaN(h) {
+			t.Errorf("DerivativeStep = %v, want NaN", h)
+		}
+	})
+}
+
+func TestDerivativeRichardson(t *testing.T) {
+	t.Run("input validation - non-positive step", func(t *testing.T) {
+		_, _, err := numericalanalysis.DerivativeRichardson(math.Exp, 1, 0, 5)
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+
+	t.Run("input validation - no levels", func(t *testing.T) {
+		_, _, err := numericalanalysis.DerivativeRichardson(math.Exp, 1, 0.1, 0)
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+
+	t.Run("exponential with large initial step", func(t *testing.T) {
+		d, e, err := numericalanalysis.DerivativeRichardson(math.Exp, 1, 0.5, 10)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(d-math.E) > 1e-12 {
+			t.Errorf("Derivative = %v, want ~%v", d, math.E)
+		}
+		if e > 1e-10 || math.Abs(d-math.E) > 10*e+1e-15 {
+			t.Errorf("error estimate = %v, actual error = %v", e, math.Abs(d-math.E))
+		}
+	})
+
+	t.Run("oscillating function", func(t *testing.T) {
+		f := func(x float64) float64 { return math.Sin(10 * x) }
+		d, e, err := numericalanalysis.DerivativeRichardson(f, 0.3, 0.1, 10)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		expected := 10 * math.Cos(3)
+		if math.Abs(d-expected) > 1e-9 {
+			t.Errorf("Derivative = %v, want ~%v (error estimate %v)", d, expected, e)
+		}
+	})
+}
+
+func TestGradient(t *testing.T) {
+	x := []float64{1.3, 0.4}
+
+	t.Run("explicit steps", func(t *testing.T) {
+		grad, err := numericalanalysis.Gradient(rosenbrock, x, []float64{1e-5, 1e-5}, numericalanalysis.CentralDifference)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		assertSlice(t, "grad", grad, rosenbrockGrad(x), 1e-6)
+	})
+
+	t.Run("automatic steps", func(t *testing.T) {
+		grad, err := numericalanalysis.Gradient(rosenbrock, x, nil, numericalanalysis.FivePointDifference)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		assertSlice(t, "grad", grad, rosenbrockGrad(x), 1e-8)
+	})
+
+	t.Run("input validation - mismatched steps", func(t *testing.T) {
+		_, err := numericalanalysis.Gradient(rosenbrock, x, []float64{1e-5}, numericalanalysis.CentralDifference)
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+
+	t.Run("input validation - non-positive step", func(t *testing.T) {
+		_, err := numericalanalysis.Gradient(rosenbrock, x, []float64{1e-5, 0}, numericalanalysis.CentralDifference)
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+
+	t.Run("input validation - unknown scheme", func(t *testing.T) {
+		_, err := numericalanalysis.Gradient(rosenbrock, x, nil, 3)
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+}
+
+func TestJacobian(t *testing.T) {
+	f := []func(u []float64) float64{
+		func(u []float64) float64 { return u[0]*u[0] + u[1]*u[1] - 4 },
+		func(u []float64) float64 { return math.Sin(u[0] * u[1]) },
+		func(u []float64) float64 { return u[0] },
+	}
+
+	J, err := numericalanalysis.Jacobian(f, []float64{1, 2}, nil, numericalanalysis.CentralDifference)
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+	expected := numericalanalysis.Matrix{
+		{2, 4},
+		{2 * math.Cos(2), math.Cos(2)},
+		{1, 0},
+	}
+	for i := range expected {
+		assertSlice(t, "J", J[i], expected[i], 1e-8)
+	}
+}
+
+func TestHessian(t *testing.T) {
+	x := []float64{1.3, 0.4}
+	expected := numericalanalysis.Matrix{
+		{2 - 400*(x[1]-3*x[0]*x[0]), -400 * x[0]},
+		{-400 * x[0], 200},
+	}
+
+	t.Run("automatic steps", func(t *testing.T) {
+		H, err := numericalanalysis.Hessian(rosenbrock, x, nil)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		for i := range expected {
+			assertSlice(t, "H", H[i], expected[i], 1e-4)
+		}
+	})
+
+	t.Run("input validation - empty point", func(t *testing.T) {
+		_, err := numericalanalysis.Hessian(rosenbrock, []float64{}, nil)
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+}
+
+func TestParallelDifferences(t *testing.T) {
+	// f(x) = Σ sin(x_i)·x_{i+1} + x_i²
+	f := func(x []float64) float64 {
+		res := 0.
+		for i := range x {
+			res += x[i] * x[i]
+			if i+1 < len(x) {
+				res += math.Sin(x[i]) * x[i+1]
+			}
+		}
+		return res
+	}
+	x := []float64{0.3, -1.2, 2.5, 0.7, -0.4}
+
+	schemes := map[string]numericalanalysis.DifferenceScheme{
+		"forward":    numericalanalysis.ForwardDifference,
+		"central":    numericalanalysis.CentralDifference,
+		"five-point": numericalanalysis.FivePointDifference,
+	}
+	for name, scheme := range schemes {
+		t.Run("GradientParallel - matches Gradient - "+name, func(t *testing.T) {
+			expected, err := numericalanalysis.Gradient(f, x, nil, scheme)
+			if err != nil {
+				t.Fatalf("err = %v, want nil", err)
+			}
+			grad, err := numericalanalysis.GradientParallel(f, x, nil, scheme, 4)
+			if err != nil {
+				t.Fatalf("err = %v, want nil", err)
+			}
+			assertSlice(t, "grad", grad, expected, 0)
+		})
+	}
+
+	t.Run("HessianParallel - matches Hessian", func(t *testing.T) {
+		expected, err := numericalanalysis.Hessian(f, x, nil)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		H, err := numericalanalysis.HessianParallel(f, x, nil, 8)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		for i := range expected {
+			assertSlice(t, "H", H[i], expected[i], 0)
+		}
+	})
+
+	t.Run("input validation - no workers", func(t *testing.T) {
+		if _, err := numericalanalysis.GradientParallel(f, x, nil, numericalanalysis.CentralDifference, 0); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("GradientParallel err = %v, want ErrWrongInput", err)
+		}
+		if _, err := numericalanalysis.HessianParallel(f, x, nil, 0); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("HessianParallel err = %v, want ErrWrongInput", err)
+		}
+	})
+}
@@ -0,0 +1,263 @@
+package numericalanalysis
+
+import (
+	"math"
+	"sort"
+	"sync"
+)
+
+// gauss.go
+// Gaussian quadrature: Gauss–Legendre, Gauss–Laguerre and Gauss–Hermite rules
+
+// GaussRule is a quadrature rule ∫ w(x) g(x) dx ≈ Σ Weights[i]·g(Nodes[i]), Gaussian unless stated otherwise
+type GaussRule struct {
+	Nodes   []float64 // Nodes in ascending order
+	Weights []float64 // Weights, all positive
+}
+
+// Integrate applies the rule to g, i.e. approximates the integral of w(x)·g(x) for the weight w of the rule
+func (r GaussRule) Integrate(g Func1D) float64 {
+	res := 0.
+	for i := range r.Nodes {
+		res += r.Weights[i] * g(r.Nodes[i])
+	}
+	return res
+}
+
+// gaussFamily is a family of orthogonal polynomials
+type gaussFamily int
+
+const (
+	gaussLegendre gaussFamily = iota
+	gaussLaguerre
+	gaussHermite
+)
+
+// gaussRules caches the rules that were already computed
+var gaussRules = struct {
+	sync.Mutex
+	rules map[[2]int]GaussRule
+}{rules: map[[2]int]GaussRule{}}
+
+// GaussLegendreRule returns the n-point rule for w(x) = 1 on [-1, 1], exact for polynomials of degree 2n-1
+func GaussLegendreRule(n int) (GaussRule, error) {
+	return gaussRule(gaussLegendre, n)
+}
+
+// GaussLaguerreRule returns the n-point rule for w(x) = e^(-x) on [0, ∞)
+func GaussLaguerreRule(n int) (GaussRule, error) {
+	return gaussRule(gaussLaguerre, n)
+}
+
+// GaussHermiteRule returns the n-point rule for w(x) = e^(-x²) on (-∞, ∞)
+func GaussHermiteRule(n int) (GaussRule, error) {
+	return gaussRule(gaussHermite, n)
+}
+
+// IntegralGaussLegendre calculates the integral of f over the finite interval [a, b] with the n-point Gauss–Legendre rule
+func IntegralGaussLegendre(f Func1D, a, b float64, n int) (float64, error) {
+	rule, err := GaussLegendreRule(n)
+	if err != nil {
+		return 0, err
+	}
+	c, h := (a+b)/2, (b-a)/2
+	return h * rule.Integrate(func(t float64) float64 { return f(c + h*t) }), nil
+}
+
+// IntegralGaussLaguerre calculates the integral of f over [a, ∞) with the n-point Gauss–Laguerre rule.
+// f should decay like e^(-x); for (-∞, b] integrate f(-x) over [-b, ∞).
+func IntegralGaussLaguerre(f Func1D, a float64, n int) (float64, error) {
+	rule, err := GaussLaguerreRule(n)
+	if err != nil {
+		return 0, err
+	}
+	// ∫[a,∞) f(x) dx = ∫[0,∞) e^(-t) · e^t f(a+t) dt
+	return rule.integrateUnweighted(func(t float64) float64 { return t }, func(t float64) float64 { return f(a + t) }), nil
+}
+
+// IntegralGaussHermite calculates the integral of f over (-∞, ∞) with the n-point Gauss–Hermite rule.
+// f should decay like e^(-x²).
+func IntegralGaussHermite(f Func1D, n int) (float64, error) {
+	rule, err := GaussHermiteRule(n)
+	if err != nil {
+		return 0, err
+	}
+	// ∫ f(x) dx = ∫ e^(-x²) · e^(x²) f(x) dx
+	return rule.integrateUnweighted(func(x float64) float64 { return x * x }, f), nil
+}
+
+// integrateUnweighted approximates ∫ g(x) dx with a rule for the weight w(x) = e^(-s(x)).
+// Every weight is multiplied by e^(s(x)) in log space, so that the factor does not overflow at the outer nodes of high orders;
+// weights that underflowed to zero are skipped, their terms are below the resolution of the result for g decaying like w.
+func (r GaussRule) integrateUnweighted(s, g Func1D) float64 {
+	res := 0.
+	for i, x := range r.Nodes {
+		if r.Weights[i] == 0 {
+			continue
+		}
+		res += math.Exp(math.Log(r.Weights[i])+s(x)) * g(x)
+	}
+	return res
+}
+
+// gaussRule returns a copy of the cached rule, computing it on the first request
+func gaussRule(family gaussFamily, n int) (GaussRule, error) {
+	// Check input
+	if n < 1 {
+		return GaussRule{}, ErrWrongInput
+	}
+
+	gaussRules.Lock()
+	defer gaussRules.Unlock()
+
+	key := [2]int{int(family), n}
+	rule, ok := gaussRules.rules[key]
+	if !ok {
+		var err error
+		rule, err = golubWelsch(family, n)
+		if err != nil {
+			return GaussRule{}, err
+		}
+		gaussRules.rules[key] = rule
+	}
+
+	return GaussRule{
+		Nodes:   append([]float64(nil), rule.Nodes...),
+		Weights: append([]float64(nil), rule.Weights...),
+	}, nil
+}
+
+// golubWelsch computes the rule from the eigenvalues and eigenvectors of the Jacobi matrix of the family.
+// The nodes are the eigenvalues, the weights are μ0·v0² for the normalized eigenvectors v.
+func golubWelsch(family gaussFamily, n int) (GaussRule, error) {
+	// Three-term recurrence: diagonal d, off-diagonal e, μ0 = ∫ w(x) dx
+	d := make([]float64, n)
+	e := make([]float64, n)
+	var mu0 float64
+	switch family {
+	case gaussLegendre:
+		mu0 = 2
+		for k := 1; k < n; k++ {
+			e[k-1] = float64(k) / math.Sqrt(4*float64(k*k)-1)
+		}
+	case gaussLaguerre:
+		mu0 = 1
+		for k := range n {
+			d[k] = float64(2*k + 1)
+			if k > 0 {
+				e[k-1] = float64(k)
+			}
+		}
+	case gaussHermite:
+		mu0 = math.Sqrt(math.Pi)
+		for k := 1; k < n; k++ {
+			e[k-1] = math.Sqrt(float64(k) / 2)
+		}
+	}
+
+	nodes, v0, err := tridiagonalEigen(d, e)
+	if err != nil {
+		return GaussRule{}, err
+	}
+
+	rule := GaussRule{Nodes: nodes, Weights: make([]float64, n)}
+	for i := range n {
+		rule.Weights[i] = mu0 * v0[i] * v0[i]
+	}
+	sortRule(rule)
+
+	// Symmetric families: remove the rounding asymmetry
+	if family != gaussLaguerre {
+		for i := range n / 2 {
+			j := n - 1 - i
+			x := (rule.Nodes[j] - rule.Nodes[i]) / 2
+			w := (rule.Weights[i] + rule.Weights[j]) / 2
+			rule.Nodes[i], rule.Nodes[j] = -x, x
+			rule.Weights[i], rule.Weights[j] = w, w
+		}
+		if n%2 == 1 {
+			rule.Nodes[n/2] = 0
+		}
+	}
+
+	return rule, nil
+}
+
+// sortRule sorts the nodes of the rule in ascending order together with their weights
+func sortRule(rule GaussRule) {
+	sort.Sort(ruleByNode(rule))
+}
+
+// ruleByNode implements sort.Interface for the nodes of a rule
+type ruleByNode GaussRule
+
+func (r ruleByNode) Len() int           { return len(r.Nodes) }
+func (r ruleByNode) Less(i, j int) bool { return r.Nodes[i] < r.Nodes[j] }
+func (r ruleByNode) Swap(i, j int) {
+	r.Nodes[i], r.Nodes[j] = r.Nodes[j], r.Nodes[i]
+	r.Weights[i], r.Weights[j] = r.Weights[j], r.Weights[i]
+}
+
+// tridiagonalEigen calculates the eigenvalues of a symmetric tridiagonal matrix and the first components
+// of its normalized eigenvectors with the implicit QL method.
+// d[n]: diagonal (overwritten)
+// e[n]: off-diagonal, e[i] couples i and i+1, e[n-1] is unused (overwritten)
+func tridiagonalEigen(d, e []float64) ([]float64, []float64, error) {
+	n := len(d)
+	z := make([]float64, n)
+	z[0] = 1
+
+	for l := range n {
+		for iter := 0; ; iter++ {
+			// Find a small off-diagonal element
+			m := l
+			for ; m < n-1; m++ {
+				if math.Abs(e[m]) <= epsilon*(math.Abs(d[m])+math.Abs(d[m+1])) {
+					break
+				}
+			}
+			if m == l {
+				break
+			}
+			if iter == 50 {
+				return nil, nil, ErrDidNotConverge
+			}
+
+			// Implicit shift
+			g := (d[l+1] - d[l]) / (2 * e[l])
+			r := math.Hypot(g, 1)
+			g = d[m] - d[l] + e[l]/(g+math.Copysign(r, g))
+			s, c, p := 1., 1., 0.
+			i := m - 1
+			for ; i >= l; i-- {
+				f, b := s*e[i], c*e[i]
+				r = math.Hypot(f, g)
+				e[i+1] = r
+				if r == 0 { // Recover from underflow
+					d[i+1] -= p
+					e[m] = 0
+					break
+				}
+				s, c = f/r, g/r
+				g = d[i+1] - p
+				r = (d[i]-g)*s + 2*c*b
+				p = s * r
+				d[i+1] = g + p
+				g = c*r - b
+
+				// Rotate the first row of the eigenvector matrix
+				f = z[i+1]
+				z[i+1] = s*z[i] + c*f
+				z[i] = c*z[i] - s*f
+			}
+			if r == 0 && i >= l {
+				continue
+			}
+			d[l] -= p
+			e[l] = g
+			e[m] = 0
+		}
+	}
+
+	return d, z, nil
+}
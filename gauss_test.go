@@ -0,0 +1,169 @@
+package numericalanalysis_test
+
+import (
+	"math"
+	"testing"
+
+	numericalanalysis "github.com/Russia9/numerical-analysis"
+)
+
+func TestGaussRules(t *testing.T) {
+	t.Run("GaussLegendreRule - three points", func(t *testing.T) {
+		rule, err := numericalanalysis.GaussLegendreRule(3)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		assertSlice(t, "Nodes", rule.Nodes, []float64{-math.Sqrt(0.6), 0, math.Sqrt(0.6)}, 1e-14)
+		assertSlice(t, "Weights", rule.Weights, []float64{5. / 9, 8. / 9, 5. / 9}, 1e-14)
+	})
+
+	t.Run("GaussLaguerreRule - two points", func(t *testing.T) {
+		rule, err := numericalanalysis.GaussLaguerreRule(2)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		assertSlice(t, "Nodes", rule.Nodes, []float64{2 - math.Sqrt2, 2 + math.Sqrt2}, 1e-14)
+		assertSlice(t, "Weights", rule.Weights, []float64{(2 + math.Sqrt2) / 4, (2 - math.Sqrt2) / 4}, 1e-14)
+	})
+
+	t.Run("GaussHermiteRule - two points", func(t *testing.T) {
+		rule, err := numericalanalysis.GaussHermiteRule(2)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		w := math.Sqrt(math.Pi) / 2
+		assertSlice(t, "Nodes", rule.Nodes, []float64{-1 / math.Sqrt2, 1 / math.Sqrt2}, 1e-14)
+		assertSlice(t, "Weights", rule.Weights, []float64{w, w}, 1e-14)
+	})
+
+	t.Run("exact for polynomials of degree 2n-1", func(t *testing.T) {
+		n := 20
+		legendre, _ := numericalanalysis.GaussLegendreRule(n)
+		laguerre, _ := numericalanalysis.GaussLaguerreRule(n)
+		hermite, _ := numericalanalysis.GaussHermiteRule(n)
+
+		factorial := 1.
+		for k := 0; k < 2*n; k++ {
+			if k > 0 {
+				factorial *= float64(k)
+			}
+			p := func(x float64) float64 { return math.Pow(x, float64(k)) }
+
+			// ∫[-1,1] x^k dx
+			expected := 0.
+			if k%2 == 0 {
+				expected = 2 / float64(k+1)
+			}
+			if got := legendre.Integrate(p); math.Abs(got-expected) > 1e-13 {
+				t.Errorf("Legendre x^%d = %v, want %v", k, got, expected)
+			}
+
+			// ∫[0,∞) e^(-x) x^k dx = k!
+			if got := laguerre.Integrate(p); math.Abs(got-factorial) > 1e-10*factorial {
+				t.Errorf("Laguerre x^%d = %v, want %v", k, got, factorial)
+			}
+
+			// ∫ e^(-x²) x^k dx = Γ((k+1)/2) for even k, odd moments cancel up to rounding of the terms
+			expected = 0
+			if k%2 == 0 {
+				expected = math.Gamma(float64(k+1) / 2)
+			}
+			if got := hermite.Integrate(p); math.Abs(got-expected) > 1e-10*math.Gamma(float64(k+2)/2) {
+				t.Errorf("Hermite x^%d = %v, want %v", k, got, expected)
+			}
+		}
+	})
+
+	t.Run("high order weights sum", func(t *testing.T) {
+		rule, err := numericalanalysis.GaussLegendreRule(200)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		sum := 0.
+		for i := range rule.Weights {
+			sum += rule.Weights[i]
+			if rule.Weights[i] <= 0 || (i > 0 && rule.Nodes[i] <= rule.Nodes[i-1]) {
+				t.Fatalf("Weights[%d] = %v, Nodes not ascending", i, rule.Weights[i])
+			}
+		}
+		if math.Abs(sum-2) > 1e-12 {
+			t.Errorf("sum = %v, want 2", sum)
+		}
+	})
+
+	t.Run("cached rules are copies", func(t *testing.T) {
+		rule, _ := numericalanalysis.GaussLegendreRule(4)
+		rule.Nodes[0] = 100
+		again, _ := numericalanalysis.GaussLegendreRule(4)
+		if again.Nodes[0] == 100 {
+			t.Errorf("Nodes[0] = %v, cached rule was modified", again.Nodes[0])
+		}
+	})
+
+	t.Run("input validation", func(t *testing.T) {
+		if _, err := numericalanalysis.GaussLegendreRule(0); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+		if _, err := numericalanalysis.IntegralGaussHermite(math.Exp, -1); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+}
+
+func TestIntegralGauss(t *testing.T) {
+	t.Run("IntegralGaussLegendre - sine", func(t *testing.T) {
+		// ∫[0,π] sin x dx = 2
+		res, err := numericalanalysis.IntegralGaussLegendre(math.Sin, 0, math.Pi, 12)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(res-2) > 1e-13 {
+			t.Errorf("result = %v, want ~2", res)
+		}
+	})
+
+	t.Run("IntegralGaussLaguerre - semi-infinite", func(t *testing.T) {
+		// ∫[1,∞) x e^(-x) dx = 2/e
+		f := func(x float64) float64 { return x * math.Exp(-x) }
+		res, err := numericalanalysis.IntegralGaussLaguerre(f, 1, 10)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(res-2/math.E) > 1e-12 {
+			t.Errorf("result = %v, want ~%v", res, 2/math.E)
+		}
+	})
+
+	t.Run("high orders stay finite", func(t *testing.T) {
+		// e^t at the outer Laguerre nodes and e^(x²) at the outer Hermite nodes overflow for these orders
+		for _, n := range []int{200, 300, 400} {
+			res, err := numericalanalysis.IntegralGaussLaguerre(func(x float64) float64 { return x * math.Exp(-x) }, 1, n)
+			if err != nil {
+				t.Fatalf("Laguerre n = %d: err = %v, want nil", n, err)
+			}
+			if math.Abs(res-2/math.E) > 1e-12 {
+				t.Errorf("Laguerre n = %d: result = %v, want ~%v", n, res, 2/math.E)
+			}
+
+			res, err = numericalanalysis.IntegralGaussHermite(func(x float64) float64 { return math.Exp(-(x - 1) * (x - 1) / 2) }, n)
+			if err != nil {
+				t.Fatalf("Hermite n = %d: err = %v, want nil", n, err)
+			}
+			if math.Abs(res-math.Sqrt(2*math.Pi)) > 1e-12 {
+				t.Errorf("Hermite n = %d: result = %v, want ~%v", n, res, math.Sqrt(2*math.Pi))
+			}
+		}
+	})
+
+	t.Run("IntegralGaussHermite - gaussian", func(t *testing.T) {
+		// ∫ e^(-(x-1)²/2) dx = √(2π)
+		f := func(x float64) float64 { return math.Exp(-(x - 1) * (x - 1) / 2) }
+		res, err := numericalanalysis.IntegralGaussHermite(f, 40)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(res-math.Sqrt(2*math.Pi)) > 1e-6 {
+			t.Errorf("result = %v, want ~%v", res, math.Sqrt(2*math.Pi))
+		}
+	})
+}
@@ -0,0 +1,244 @@
+package numericalanalysis
+
+import (
+	"context"
+	"math"
+	"math/rand"
+)
+
+// global.go
+// Stochastic global minimizers
+
+// SimulatedAnnealingExtremum method for finding a global minimum of a function of many variables in a box
+// f: function to minimize
+// lower, upper: finite bounds for each variable
+// T0: initial temperature
+// cooling: temperature multiplier applied every iteration, cooling in (0,1)
+// maxIter: number of iterations
+// rng: random number generator (seed it for reproducible results)
+// polish: refine the best point with ProjectedLBFGSExtremum
+func SimulatedAnnealingExtremum(f func(x []float64) float64, lower, upper []float64, T0, cooling float64, maxIter int, rng *rand.Rand, polish bool) ([]float64, error) {
+	return SimulatedAnnealingExtremumContext(context.Background(), 0, f, lower, upper, T0, cooling, maxIter, rng, polish)
+}
+
+// SimulatedAnnealingExtremumContext is SimulatedAnnealingExtremum that stops on ctx cancellation
+// or after maxEvals evaluations of f (0 = unlimited)
+func SimulatedAnnealingExtremumContext(ctx context.Context, maxEvals int, f func(x []float64) float64, lower, upper []float64, T0, cooling float64, maxIter int, rng *rand.Rand, polish bool) ([]float64, error) {
+	n := len(lower)
+
+	// Check input
+	if err := checkBox(lower, upper); err != nil {
+		return nil, err
+	}
+	if T0 <= 0 || cooling <= 0 || cooling >= 1 || maxIter <= 0 || rng == nil {
+		return nil, ErrWrongInput
+	}
+	budget, err := newEvalBudget(ctx, maxEvals)
+	if err != nil {
+		return nil, err
+	}
+	f = budget.funcVec(f)
+
+	// Start from a random point
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = lower[i] + rng.Float64()*(upper[i]-lower[i])
+	}
+	fx := f(x)
+	best := make([]float64, n)
+	copy(best, x)
+	fBest := fx
+
+	T := T0
+	for range maxIter {
+		// Check cancellation and budget
+		if err := budget.check(); err != nil {
+			return nil, err
+		}
+
+		// Gaussian neighbour, the step shrinks with the temperature
+		scale := 0.1 * math.Sqrt(T/T0)
+		y := make([]float64, n)
+		for i := range y {
+			y[i] = reflectIntoBox(x[i]+rng.NormFloat64()*scale*(upper[i]-lower[i]), lower[i], upper[i])
+		}
+		fy := f(y)
+
+		// Metropolis criterion
+		if fy < fx || rng.Float64() < math.Exp((fx-fy)/T) {
+			x, fx = y, fy
+			if fx < fBest {
+				copy(best, x)
+				fBest = fx
+			}
+		}
+
+		T *= cooling
+	}
+
+	if err := budget.check(); err != nil {
+		return nil, err
+	}
+
+	// Polishing that runs out of budget keeps the unpolished point
+	if polish {
+		best = polishExtremum(ctx, budget, f, best, fBest, lower, upper)
+	}
+
+	return best, nil
+}
+
+// DifferentialEvolutionExtremum method (DE/rand/1/bin) for finding a global minimum of a function of many variables in a box
+// f: function to minimize
+// lower, upper: finite bounds for each variable
+// popSize: population size, popSize >= 4
+// F: differential weight, F in (0,2]
+// CR: crossover probability, CR in [0,1]
+// maxGen: maximum number of generations
+// tol: stop when the spread of function values in the population is below tol
+// rng: random number generator (seed it for reproducible results)
+// polish: refine the best point with ProjectedLBFGSExtremum
+func DifferentialEvolutionExtremum(f func(x []float64) float64, lower, upper []float64, popSize int, F, CR float64, maxGen int, tol float64, rng *rand.Rand, polish bool) ([]float64, error) {
+	return DifferentialEvolutionExtremumContext(context.Background(), 0, f, lower, upper, popSize, F, CR, maxGen, tol, rng, polish)
+}
+
+// DifferentialEvolutionExtremumContext is DifferentialEvolutionExtremum that stops on ctx cancellation
+// or after maxEvals evaluations of f (0 = unlimited)
+func DifferentialEvolutionExtremumContext(ctx context.Context, maxEvals int, f func(x []float64) float64, lower, upper []float64, popSize int, F, CR float64, maxGen int, tol float64, rng *rand.Rand, polish bool) ([]float64, error) {
+	n := len(lower)
+
+	// Check input
+	if err := checkBox(lower, upper); err != nil {
+		return nil, err
+	}
+	if popSize < 4 || F <= 0 || F > 2 || CR < 0 || CR > 1 || maxGen <= 0 || tol < 0 || rng == nil {
+		return nil, ErrWrongInput
+	}
+	budget, err := newEvalBudget(ctx, maxEvals)
+	if err != nil {
+		return nil, err
+	}
+	f = budget.funcVec(f)
+
+	// Random initial population
+	pop := make([][]float64, popSize)
+	fPop := make([]float64, popSize)
+	for k := range pop {
+		pop[k] = make([]float64, n)
+		for i := range n {
+			pop[k][i] = lower[i] + rng.Float64()*(upper[i]-lower[i])
+		}
+		fPop[k] = f(pop[k])
+	}
+
+	for range maxGen {
+		// Check cancellation and budget
+		if err := budget.check(); err != nil {
+			return nil, err
+		}
+
+		// Check population spread
+		fMin, fMax := math.Inf(1), math.Inf(-1)
+		for k := range fPop {
+			fMin = math.Min(fMin, fPop[k])
+			fMax = math.Max(fMax, fPop[k])
+		}
+		if fMax-fMin <= tol {
+			break
+		}
+
+		for k := range pop {
+			// Pick three distinct members different from k
+			a, b, c := k, k, k
+			for a == k {
+				a = rng.Intn(popSize)
+			}
+			for b == k || b == a {
+				b = rng.Intn(popSize)
+			}
+			for c == k || c == a || c == b {
+				c = rng.Intn(popSize)
+			}
+
+			// Mutation and binomial crossover
+			trial := make([]float64, n)
+			jRand := rng.Intn(n)
+			for i := range n {
+				if i == jRand || rng.Float64() < CR {
+					trial[i] = reflectIntoBox(pop[a][i]+F*(pop[b][i]-pop[c][i]), lower[i], upper[i])
+				} else {
+					trial[i] = pop[k][i]
+				}
+			}
+
+			// Selection
+			if fTrial := f(trial); fTrial <= fPop[k] {
+				pop[k], fPop[k] = trial, fTrial
+			}
+		}
+	}
+
+	bestK := 0
+	for k := range fPop {
+		if fPop[k] < fPop[bestK] {
+			bestK = k
+		}
+	}
+	best := pop[bestK]
+
+	if err := budget.check(); err != nil {
+		return nil, err
+	}
+
+	// Polishing that runs out of budget keeps the unpolished point
+	if polish {
+		best = polishExtremum(ctx, budget, f, best, fPop[bestK], lower, upper)
+	}
+
+	return best, nil
+}
+
+// checkBox checks that the bounds are finite and ordered
+func checkBox(lower, upper []float64) error {
+	if len(lower) == 0 || len(lower) != len(upper) {
+		return ErrWrongInput
+	}
+	for i := range lower {
+		if math.IsInf(lower[i], 0) || math.IsInf(upper[i], 0) || math.IsNaN(lower[i]) || math.IsNaN(upper[i]) || lower[i] >= upper[i] {
+			return ErrWrongInput
+		}
+	}
+	return nil
+}
+
+// reflectIntoBox maps x back into [lo, hi] by reflecting it at the bounds
+func reflectIntoBox(x, lo, hi float64) float64 {
+	w := hi - lo
+	x = math.Mod(x-lo, 2*w)
+	if x < 0 {
+		x += 2 * w
+	}
+	if x > w {
+		x = 2*w - x
+	}
+	return lo + x
+}
+
+// polishExtremum refines x with at most 100 iterations of ProjectedLBFGSExtremum, keeping x if the refined point
+// is not better than fx or the budget runs out
+func polishExtremum(ctx context.Context, budget *evalBudget, f func(x []float64) float64, x []float64, fx float64, lower, upper []float64) []float64 {
+	deltaX := make([]float64, len(x))
+	for i := range deltaX {
+		deltaX[i] = 1e-6 * (upper[i] - lower[i])
+	}
+
+	polished, err := ProjectedLBFGSExtremumContext(ctx, 0, f, nil, x, lower, upper, deltaX, 5, 1e-8, 100)
+	if err != nil || budget.check() != nil {
+		return x
+	}
+	fp := f(polished)
+	if math.IsNaN(fp) || budget.check() != nil || fp > fx {
+		return x
+	}
+	return polished
+}
@@ -0,0 +1,171 @@
+package numericalanalysis_test
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"testing"
+
+	numericalanalysis "github.com/Russia9/numerical-analysis"
+)
+
+// rastrigin has many local minima and a global minimum at the origin
+func rastrigin(x []float64) float64 {
+	res := 10 * float64(len(x))
+	for i := range x {
+		res += x[i]*x[i] - 10*math.Cos(2*math.Pi*x[i])
+	}
+	return res
+}
+
+func TestSimulatedAnnealingExtremum(t *testing.T) {
+	lower := []float64{-5.12, -5.12}
+	upper := []float64{5.12, 5.12}
+
+	t.Run("input validation - infinite bounds", func(t *testing.T) {
+		_, err := numericalanalysis.SimulatedAnnealingExtremum(rastrigin, []float64{math.Inf(-1), 0}, upper, 10, 0.99, 100, rand.New(rand.NewSource(1)), false)
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+
+	t.Run("input validation - invalid cooling", func(t *testing.T) {
+		_, err := numericalanalysis.SimulatedAnnealingExtremum(rastrigin, lower, upper, 10, 1, 100, rand.New(rand.NewSource(1)), false)
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+
+	t.Run("input validation - missing generator", func(t *testing.T) {
+		_, err := numericalanalysis.SimulatedAnnealingExtremum(rastrigin, lower, upper, 10, 0.99, 100, nil, false)
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+
+	t.Run("rastrigin function with polishing", func(t *testing.T) {
+		maxIter := 20000
+		cooling := math.Pow(1e-6, 1/float64(maxIter))
+
+		result, err := numericalanalysis.SimulatedAnnealingExtremum(rastrigin, lower, upper, 10, cooling, maxIter, rand.New(rand.NewSource(42)), true)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(result[0]) > 1e-4 || math.Abs(result[1]) > 1e-4 {
+			t.Errorf("result = %v, want ~[0 0]", result)
+		}
+	})
+
+	t.Run("linear function with the minimum in a corner and polishing", func(t *testing.T) {
+		// f(x,y) = x + y in [0,1]x[0,1], minimum at (0, 0); the gradient never vanishes
+		f := func(x []float64) float64 { return x[0] + x[1] }
+
+		result, err := numericalanalysis.SimulatedAnnealingExtremum(f, []float64{0, 0}, []float64{1, 1}, 1, 0.99, 500, rand.New(rand.NewSource(4)), true)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if result[0] > 1e-8 || result[1] > 1e-8 {
+			t.Errorf("result = %v, want ~[0 0]", result)
+		}
+	})
+
+	t.Run("reproducible with the same seed", func(t *testing.T) {
+		a, _ := numericalanalysis.SimulatedAnnealingExtremum(rastrigin, lower, upper, 10, 0.999, 1000, rand.New(rand.NewSource(7)), false)
+		b, _ := numericalanalysis.SimulatedAnnealingExtremum(rastrigin, lower, upper, 10, 0.999, 1000, rand.New(rand.NewSource(7)), false)
+		for i := range a {
+			if a[i] != b[i] {
+				t.Errorf("results differ: %v and %v", a, b)
+				break
+			}
+		}
+	})
+}
+
+func TestDifferentialEvolutionExtremum(t *testing.T) {
+	lower := []float64{-5.12, -5.12, -5.12}
+	upper := []float64{5.12, 5.12, 5.12}
+
+	t.Run("input validation - small population", func(t *testing.T) {
+		_, err := numericalanalysis.DifferentialEvolutionExtremum(rastrigin, lower, upper, 3, 0.8, 0.9, 100, 1e-8, rand.New(rand.NewSource(1)), false)
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+
+	t.Run("input validation - inverted bounds", func(t *testing.T) {
+		_, err := numericalanalysis.DifferentialEvolutionExtremum(rastrigin, []float64{1, 0, 0}, []float64{0, 1, 1}, 20, 0.8, 0.9, 100, 1e-8, rand.New(rand.NewSource(1)), false)
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+
+	t.Run("rastrigin function", func(t *testing.T) {
+		result, err := numericalanalysis.DifferentialEvolutionExtremum(rastrigin, lower, upper, 40, 0.7, 0.9, 1000, 1e-12, rand.New(rand.NewSource(42)), false)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		for i := range result {
+			if math.Abs(result[i]) > 1e-4 {
+				t.Errorf("result = %v, want ~[0 0 0]", result)
+				break
+			}
+		}
+	})
+
+	t.Run("minimum on the boundary with polishing", func(t *testing.T) {
+		// f(x,y) = (x-1)^2 + (y+3)^2 in [0,2]x[-2,2], minimum at (1, -2) on the boundary
+		f := func(x []float64) float64 {
+			return (x[0]-1)*(x[0]-1) + (x[1]+3)*(x[1]+3)
+		}
+
+		result, err := numericalanalysis.DifferentialEvolutionExtremum(f, []float64{0, -2}, []float64{2, 2}, 20, 0.8, 0.9, 300, 1e-12, rand.New(rand.NewSource(3)), true)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(result[0]-1) > 1e-4 || math.Abs(result[1]+2) > 1e-4 {
+			t.Errorf("result = %v, want ~[1 -2]", result)
+		}
+	})
+
+	t.Run("polishing that runs out of budget keeps the unpolished point", func(t *testing.T) {
+		// 20 members over 1 + 50 generations take 1020 evaluations, leaving 10 for polishing
+		want, err := numericalanalysis.DifferentialEvolutionExtremum(rastrigin, lower, upper, 20, 0.8, 0.9, 50, 0, rand.New(rand.NewSource(7)), false)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		result, err := numericalanalysis.DifferentialEvolutionExtremumContext(context.Background(), 1030, rastrigin, lower, upper, 20, 0.8, 0.9, 50, 0, rand.New(rand.NewSource(7)), true)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		for i := range want {
+			if result[i] != want[i] {
+				t.Errorf("result = %v, want %v", result, want)
+				break
+			}
+		}
+	})
+
+	t.Run("linear function with the minimum in a corner and polishing", func(t *testing.T) {
+		// f(x,y) = x + y in [0,1]x[0,1], minimum at (0, 0); the gradient never vanishes
+		f := func(x []float64) float64 { return x[0] + x[1] }
+
+		result, err := numericalanalysis.DifferentialEvolutionExtremum(f, []float64{0, 0}, []float64{1, 1}, 20, 0.8, 0.9, 50, 1e-12, rand.New(rand.NewSource(4)), true)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if result[0] > 1e-8 || result[1] > 1e-8 {
+			t.Errorf("result = %v, want ~[0 0]", result)
+		}
+	})
+
+	t.Run("reproducible with the same seed", func(t *testing.T) {
+		a, _ := numericalanalysis.DifferentialEvolutionExtremum(rastrigin, lower, upper, 20, 0.8, 0.9, 50, 0, rand.New(rand.NewSource(7)), false)
+		b, _ := numericalanalysis.DifferentialEvolutionExtremum(rastrigin, lower, upper, 20, 0.8, 0.9, 50, 0, rand.New(rand.NewSource(7)), false)
+		for i := range a {
+			if a[i] != b[i] {
+				t.Errorf("results differ: %v and %v", a, b)
+				break
+			}
+		}
+	})
+}
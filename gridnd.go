@@ -0,0 +1,170 @@
+package numericalanalysis
+
+import (
+	"math"
+	"sync"
+)
+
+// gridnd.go
+// Interpolation on N-dimensional rectilinear grids
+
+// GridND is a rectilinear grid in N dimensions with the values in row-major order: the value at the node
+// (i_0, i_1, ..., i_{N-1}) is Values[(...(i_0·n_1 + i_1)·n_2 + ...)·n_{N-1} + i_{N-1}], n_k = len(Axes[k])
+type GridND struct {
+	Axes   [][]float64 // Strictly increasing coordinates of every axis, at least two each
+	Values []float64   // Π n_k values, the last axis varies fastest
+}
+
+// GridMode is a method of interpolation on a grid
+type GridMode int
+
+const (
+	GridLinear GridMode = iota // Multilinear, C⁰
+	GridCubic                  // Tensor-product cubic Hermite with three-point derivative estimates, C¹; bicubic Hermite in 2-D
+)
+
+// GridInterpolation builds the interpolant of the values on an N-dimensional grid. The result takes the N coordinates
+// as separate arguments, f(x, y, z), or as a slice, f(x...), and returns NaN for a wrong number of coordinates.
+// Outside the grid it continues the polynomial of the nearest cell. Evaluations do not allocate when the coordinates
+// are passed as a slice, f(x...); separate arguments make the caller allocate the slice.
+func GridInterpolation(grid GridND, mode GridMode) (func(x ...float64) float64, error) {
+	dims := len(grid.Axes)
+
+	// Check input
+	if dims == 0 || (mode != GridLinear && mode != GridCubic) {
+		return nil, ErrWrongInput
+	}
+	size := 1
+	for _, axis := range grid.Axes {
+		if len(axis) < 2 {
+			return nil, ErrWrongInput
+		}
+		for i := 1; i < len(axis); i++ {
+			if !(axis[i] > axis[i-1]) {
+				return nil, ErrWrongInput
+			}
+		}
+		size *= len(axis)
+	}
+	if len(grid.Values) != size {
+		return nil, ErrWrongInput
+	}
+
+	axes := make([][]float64, dims)
+	caches := make([]*intervalCache, dims)
+	strides := make([]int, dims)
+	stride := 1
+	for k := dims - 1; k >= 0; k-- {
+		axes[k] = append([]float64(nil), grid.Axes[k]...)
+		caches[k] = &intervalCache{x: axes[k]}
+		strides[k] = stride
+		stride *= len(axes[k])
+	}
+	values := append([]float64(nil), grid.Values...)
+
+	width := 2
+	if mode == GridCubic {
+		width = 4
+	}
+
+	// Stencil buffers are reused between evaluations, which may run concurrently
+	type stencil struct {
+		base, offset []int
+		weights      [][4]float64
+	}
+	pool := sync.Pool{New: func() any {
+		return &stencil{base: make([]int, dims), offset: make([]int, dims), weights: make([][4]float64, dims)}
+	}}
+
+	return func(x ...float64) float64 {
+		if len(x) != dims {
+			return math.NaN()
+		}
+		st := pool.Get().(*stencil)
+		defer pool.Put(st)
+		base, offset, weights := st.base, st.offset, st.weights
+
+		// Weights of the nodes base[k], ..., base[k] + width - 1 along every axis
+		for k := range dims {
+			i := caches[k].find(x[k])
+			if mode == GridLinear {
+				a := axes[k]
+				t := (x[k] - a[i]) / (a[i+1] - a[i])
+				base[k], weights[k] = i, [4]float64{1 - t, t}
+			} else {
+				base[k], weights[k] = i-1, cubicGridWeights(axes[k], i, x[k])
+			}
+		}
+
+		// Sum over the width^N nodes of the stencil, the offsets wrap around to zero at the end
+		res := 0.
+		clear(offset)
+		for {
+			w, index := 1., 0
+			for k := range dims {
+				node := base[k] + offset[k]
+				if weights[k][offset[k]] == 0 || node < 0 || node >= len(axes[k]) {
+					w = 0
+					break
+				}
+				w *= weights[k][offset[k]]
+				index += node * strides[k]
+			}
+			if w != 0 {
+				res += w * values[index]
+			}
+
+			// Next combination of offsets
+			k := dims - 1
+			for ; k >= 0; k-- {
+				offset[k]++
+				if offset[k] < width {
+					break
+				}
+				offset[k] = 0
+			}
+			if k < 0 {
+				break
+			}
+		}
+		return res
+	}, nil
+}
+
+// cubicGridWeights returns the weights of the nodes i-1, ..., i+2 of the axis in the cubic Hermite interpolant
+// on the cell [a_i, a_{i+1}] at x, with the derivatives at the nodes estimated as in gridDerivatives
+func cubicGridWeights(a []float64, i int, x float64) [4]float64 {
+	h := a[i+1] - a[i]
+	v, s := hermiteWeights((x-a[i])/h, h, false)
+
+	var w [4]float64
+	w[1], w[2] = v[0], v[1]
+	for k := range 2 {
+		first, d := derivativeWeights(a, i+k)
+		for j, c := range d {
+			if c != 0 {
+				w[first+j-(i-1)] += s[k] * c
+			}
+		}
+	}
+	return w
+}
+
+// derivativeWeights returns the weights of the values at the nodes first, first+1, first+2 in the three-point
+// estimate of the derivative at node k of the axis used by gridDerivatives
+func derivativeWeights(a []float64, k int) (int, [3]float64) {
+	n := len(a)
+	switch {
+	case n == 2:
+		h := a[1] - a[0]
+		return 0, [3]float64{-1 / h, 1 / h, 0}
+	case k == 0:
+		h0, h1 := a[1]-a[0], a[2]-a[1]
+		return 0, [3]float64{-(2*h0 + h1) / (h0 * (h0 + h1)), (h0 + h1) / (h0 * h1), -h0 / (h1 * (h0 + h1))}
+	case k == n-1:
+		h0, h1 := a[n-1]-a[n-2], a[n-2]-a[n-3]
+		return n - 3, [3]float64{h0 / (h1 * (h0 + h1)), -(h0 + h1) / (h0 * h1), (2*h0 + h1) / (h0 * (h0 + h1))}
+	}
+	h0, h1 := a[k]-a[k-1], a[k+1]-a[k]
+	return k - 1, [3]float64{-h1 / (h0 * (h0 + h1)), (h1 - h0) / (h0 * h1), h0 / (h1 * (h0 + h1))}
+}
@@ -0,0 +1,155 @@
+package numericalanalysis_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	numericalanalysis "github.com/Russia9/numerical-analysis"
+)
+
+// tabulateND builds the grid of f at the nodes of the axes in row-major order
+func tabulateND(f func(x ...float64) float64, axes ...[]float64) numericalanalysis.GridND {
+	grid := numericalanalysis.GridND{Axes: axes}
+	index := make([]int, len(axes))
+	x := make([]float64, len(axes))
+	for {
+		for k := range axes {
+			x[k] = axes[k][index[k]]
+		}
+		grid.Values = append(grid.Values, f(x...))
+
+		k := len(axes) - 1
+		for ; k >= 0; k-- {
+			index[k]++
+			if index[k] < len(axes[k]) {
+				break
+			}
+			index[k] = 0
+		}
+		if k < 0 {
+			return grid
+		}
+	}
+}
+
+// checkGridND compares the interpolant with f at random points of the box spanned by the axes
+func checkGridND(t *testing.T, g, f func(x ...float64) float64, axes [][]float64, tol float64) {
+	t.Helper()
+	rng := rand.New(rand.NewSource(22))
+	x := make([]float64, len(axes))
+	for range 300 {
+		for k, a := range axes {
+			x[k] = a[0] + (a[len(a)-1]-a[0])*rng.Float64()
+		}
+		if got, want := g(x...), f(x...); math.Abs(got-want) > tol {
+			t.Fatalf("g(%v) = %v, want %v", x, got, want)
+		}
+	}
+}
+
+func TestGridInterpolation(t *testing.T) {
+	t.Run("trilinear reproduces a multilinear function", func(t *testing.T) {
+		f := func(x ...float64) float64 { return 1 + x[0] - 2*x[1] + 3*x[2] + x[0]*x[1]*x[2] }
+		axes := [][]float64{{0, 0.3, 1}, {-1, 2}, {0, 0.5, 0.6, 2}}
+		g, err := numericalanalysis.GridInterpolation(tabulateND(f, axes...), numericalanalysis.GridLinear)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		checkGridND(t, g, f, axes, 1e-12)
+		if got := g(1, 2, 2); math.Abs(got-f(1, 2, 2)) > 1e-12 {
+			t.Errorf("g(1, 2, 2) = %v, want %v", got, f(1, 2, 2))
+		}
+	})
+
+	t.Run("cubic reproduces a quadratic in every variable in 4-D", func(t *testing.T) {
+		f := func(x ...float64) float64 { return x[0]*x[0]*x[1] - x[2]*x[2]*x[3]*x[3] + x[0]*x[3] + 2 }
+		axes := [][]float64{{0, 0.4, 1, 1.5}, {-1, 0, 1}, {0, 0.2, 0.3, 0.8, 1}, {1, 2, 4}}
+		g, err := numericalanalysis.GridInterpolation(tabulateND(f, axes...), numericalanalysis.GridCubic)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		checkGridND(t, g, f, axes, 1e-11)
+	})
+
+	t.Run("cubic is bicubic Hermite in 2-D", func(t *testing.T) {
+		f := func(x, y float64) float64 { return math.Sin(2*x) * math.Exp(y) }
+		x, y := []float64{0, 0.2, 0.5, 0.6, 1}, []float64{0, 0.5, 1}
+		grid := tabulate(f, x, y)
+		s, err := numericalanalysis.BicubicHermite(grid)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		g, err := numericalanalysis.GridInterpolation(tabulateND(func(p ...float64) float64 { return f(p[0], p[1]) }, x, y), numericalanalysis.GridCubic)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		checkGridND(t, g, func(p ...float64) float64 { return s.Value(p[0], p[1]) }, [][]float64{x, y}, 1e-13)
+	})
+
+	t.Run("converges for smooth data", func(t *testing.T) {
+		f := func(x ...float64) float64 { return math.Cos(x[0]+x[1]) * math.Exp(-x[2]) }
+		axes := [][]float64{uniform(0, 1, 21), uniform(0, 1, 21), uniform(0, 1, 21)}
+		grid := tabulateND(f, axes...)
+		linear, err := numericalanalysis.GridInterpolation(grid, numericalanalysis.GridLinear)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		cubic, err := numericalanalysis.GridInterpolation(grid, numericalanalysis.GridCubic)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		checkGridND(t, linear, f, axes, 2e-3)
+		checkGridND(t, cubic, f, axes, 1e-4)
+	})
+
+	t.Run("evaluation does not allocate", func(t *testing.T) {
+		if raceEnabled {
+			t.Skip("sync.Pool drops items under the race detector")
+		}
+		f := func(x ...float64) float64 { return x[0] + x[1]*x[2] }
+		axes := [][]float64{uniform(0, 1, 5), uniform(0, 1, 6), uniform(0, 1, 7)}
+		for _, mode := range []numericalanalysis.GridMode{numericalanalysis.GridLinear, numericalanalysis.GridCubic} {
+			g, err := numericalanalysis.GridInterpolation(tabulateND(f, axes...), mode)
+			if err != nil {
+				t.Fatalf("err = %v, want nil", err)
+			}
+			x := []float64{0.3, 0.6, 0.9}
+			if allocs := testing.AllocsPerRun(100, func() { g(x...) }); allocs != 0 {
+				t.Errorf("mode %v: %v allocations per evaluation, want 0", mode, allocs)
+			}
+		}
+	})
+
+	t.Run("wrong number of coordinates", func(t *testing.T) {
+		g, err := numericalanalysis.GridInterpolation(numericalanalysis.GridND{Axes: [][]float64{{0, 1}}, Values: []float64{1, 2}}, numericalanalysis.GridLinear)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if got := g(0.5); got != 1.5 {
+			t.Errorf("g(0.5) = %v, want 1.5", got)
+		}
+		if got := g(0.5, 1); !math.IsNaN(got) {
+			t.Errorf("g(0.5, 1) = %v, want NaN", got)
+		}
+	})
+
+	t.Run("wrong input", func(t *testing.T) {
+		tests := map[string]struct {
+			grid numericalanalysis.GridND
+			mode numericalanalysis.GridMode
+		}{
+			"no axes":        {grid: numericalanalysis.GridND{}},
+			"short axis":     {grid: numericalanalysis.GridND{Axes: [][]float64{{0}}, Values: []float64{1}}},
+			"unsorted axis":  {grid: numericalanalysis.GridND{Axes: [][]float64{{1, 0}}, Values: []float64{1, 2}}},
+			"too few values": {grid: numericalanalysis.GridND{Axes: [][]float64{{0, 1}, {0, 1}}, Values: []float64{1, 2, 3}}},
+			"unknown mode":   {grid: numericalanalysis.GridND{Axes: [][]float64{{0, 1}}, Values: []float64{1, 2}}, mode: 3},
+		}
+		for name, test := range tests {
+			if _, err := numericalanalysis.GridInterpolation(test.grid, test.mode); !errors.Is(err, numericalanalysis.ErrWrongInput) {
+				t.Errorf("%s: err = %v, want %v", name, err, numericalanalysis.ErrWrongInput)
+			}
+		}
+	})
+}
@@ -0,0 +1,133 @@
+package numericalanalysis
+
+import "math"
+
+// hermite.go
+// Shape-preserving piecewise cubic Hermite interpolation: PCHIP and Akima
+
+// HermiteSpline is a C¹ piecewise cubic interpolant given by its values and first derivatives at the points.
+// Outside the points it continues the cubic of the first or the last interval.
+type HermiteSpline struct {
+	x, y []float64 // Sorted points
+	d    []float64 // First derivatives at the points
+}
+
+// NewPCHIP builds the monotone piecewise cubic Hermite interpolant of Fritsch and Carlson.
+// It is monotone wherever the data is and does not overshoot at local extrema.
+// The points do not have to be sorted, but their X must be distinct.
+func NewPCHIP(points []Point2D) (*HermiteSpline, error) {
+	x, y, err := sortedPoints(points)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(x)
+	h, delta := secants(x, y)
+	d := make([]float64, n)
+	if n == 2 {
+		d[0], d[1] = delta[0], delta[0]
+		return &HermiteSpline{x: x, y: y, d: d}, nil
+	}
+
+	// Weighted harmonic mean of the neighbouring secants, zero at local extrema
+	for k := 1; k < n-1; k++ {
+		if delta[k-1]*delta[k] <= 0 {
+			continue
+		}
+		w1, w2 := 2*h[k]+h[k-1], h[k]+2*h[k-1]
+		d[k] = (w1 + w2) / (w1/delta[k-1] + w2/delta[k])
+	}
+	d[0] = pchipEnd(h[0], h[1], delta[0], delta[1])
+	d[n-1] = pchipEnd(h[n-2], h[n-3], delta[n-2], delta[n-3])
+
+	return &HermiteSpline{x: x, y: y, d: d}, nil
+}
+
+// NewAkima builds Akima's interpolant, which chooses the derivatives from the local secants
+// so that it follows the data without the wiggles of a cubic spline.
+// The points do not have to be sorted, but their X must be distinct.
+func NewAkima(points []Point2D) (*HermiteSpline, error) {
+	x, y, err := sortedPoints(points)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(x)
+	_, delta := secants(x, y)
+	d := make([]float64, n)
+	if n == 2 {
+		d[0], d[1] = delta[0], delta[0]
+		return &HermiteSpline{x: x, y: y, d: d}, nil
+	}
+
+	// Secants with two extrapolated ones at each end: m[k+2] = delta[k]
+	m := make([]float64, n+3)
+	copy(m[2:], delta)
+	m[1] = 2*m[2] - m[3]
+	m[0] = 2*m[1] - m[2]
+	m[n+1] = 2*m[n] - m[n-1]
+	m[n+2] = 2*m[n+1] - m[n]
+
+	for k := range n {
+		w1, w2 := math.Abs(m[k+3]-m[k+2]), math.Abs(m[k+1]-m[k])
+		if w1+w2 == 0 {
+			d[k] = (m[k+1] + m[k+2]) / 2
+		} else {
+			d[k] = (w1*m[k+1] + w2*m[k+2]) / (w1 + w2)
+		}
+	}
+
+	return &HermiteSpline{x: x, y: y, d: d}, nil
+}
+
+// Eval returns the value of the interpolant at x
+func (s *HermiteSpline) Eval(x float64) float64 {
+	i := findInterval(s.x, x)
+	h := s.x[i+1] - s.x[i]
+	t := (x - s.x[i]) / h
+	u := 1 - t
+	return (1+2*t)*u*u*s.y[i] + t*u*u*h*s.d[i] + t*t*(3-2*t)*s.y[i+1] - t*t*u*h*s.d[i+1]
+}
+
+// Derivative returns the first derivative of the interpolant at x
+func (s *HermiteSpline) Derivative(x float64) float64 {
+	i := findInterval(s.x, x)
+	h := s.x[i+1] - s.x[i]
+	t := (x - s.x[i]) / h
+	return 6*t*(t-1)*(s.y[i]-s.y[i+1])/h + (3*t*t-4*t+1)*s.d[i] + (3*t*t-2*t)*s.d[i+1]
+}
+
+// EvalErr returns the value of the spline at x, or ErrOutOfDomain outside the points
+func (s *HermiteSpline) EvalErr(x float64) (float64, error) {
+	return evalInDomain(s, x)
+}
+
+// Domain returns the smallest and the largest X of the points
+func (s *HermiteSpline) Domain() (float64, float64) {
+	return s.x[0], s.x[len(s.x)-1]
+}
+
+// secants returns the interval lengths and the slopes of the secants between sorted points
+func secants(x, y []float64) ([]float64, []float64) {
+	h := make([]float64, len(x)-1)
+	delta := make([]float64, len(x)-1)
+	for k := range h {
+		h[k] = x[k+1] - x[k]
+		delta[k] = (y[k+1] - y[k]) / h[k]
+	}
+	return h, delta
+}
+
+// pchipEnd returns the derivative at an end point from the shape-preserving three-point formula.
+// h0, delta0: length and secant of the end interval
+// h1, delta1: length and secant of its neighbour
+func pchipEnd(h0, h1, delta0, delta1 float64) float64 {
+	d := ((2*h0+h1)*delta0 - h0*delta1) / (h0 + h1)
+	switch {
+	case math.Signbit(d) != math.Signbit(delta0) || delta0 == 0:
+		return 0
+	case math.Signbit(delta0) != math.Signbit(delta1) && math.Abs(d) > 3*math.Abs(delta0):
+		return 3 * delta0
+	}
+	return d
+}
@@ -0,0 +1,134 @@
+package numericalanalysis_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	numericalanalysis "github.com/Russia9/numerical-analysis"
+)
+
+// grid tabulates f at n equally spaced points in [a, b]
+func grid(f numericalanalysis.Func1D, a, b float64, n int) []numericalanalysis.Point2D {
+	points := make([]numericalanalysis.Point2D, n)
+	for i := range points {
+		x := a + (b-a)*float64(i)/float64(n-1)
+		points[i] = numericalanalysis.Point2D{X: x, Y: f(x)}
+	}
+	return points
+}
+
+func TestNewPCHIP(t *testing.T) {
+	t.Run("monotone data gives a monotone interpolant", func(t *testing.T) {
+		// Calibration-like step: flat, steep rise, flat
+		points := []numericalanalysis.Point2D{
+			{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 2, Y: 0.1}, {X: 2.5, Y: 5}, {X: 3, Y: 9.9}, {X: 4, Y: 10}, {X: 6, Y: 10},
+		}
+		s, err := numericalanalysis.NewPCHIP(points)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		prev := s.Eval(0)
+		for x := 0.; x <= 6; x += 0.01 {
+			v := s.Eval(x)
+			if v < prev-1e-12 || v < -1e-12 || v > 10+1e-12 {
+				t.Fatalf("Eval(%v) = %v after %v: not monotone or overshoots", x, v, prev)
+			}
+			if d := s.Derivative(x); d < -1e-12 {
+				t.Fatalf("Derivative(%v) = %v, want >= 0", x, d)
+			}
+			prev = v
+		}
+		for _, p := range points {
+			if got := s.Eval(p.X); math.Abs(got-p.Y) > 1e-14 {
+				t.Errorf("Eval(%v) = %v, want %v", p.X, got, p.Y)
+			}
+		}
+	})
+
+	t.Run("converges for smooth data", func(t *testing.T) {
+		s, err := numericalanalysis.NewPCHIP(grid(math.Sin, 0, math.Pi, 100))
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		for x := 0.1; x < 3; x += 0.07 {
+			if got := s.Eval(x); math.Abs(got-math.Sin(x)) > 1e-3 {
+				t.Errorf("Eval(%v) = %v, want %v", x, got, math.Sin(x))
+			}
+			if got := s.Derivative(x); math.Abs(got-math.Cos(x)) > 5e-2 {
+				t.Errorf("Derivative(%v) = %v, want %v", x, got, math.Cos(x))
+			}
+		}
+	})
+
+	t.Run("two points give a line", func(t *testing.T) {
+		s, err := numericalanalysis.NewPCHIP([]numericalanalysis.Point2D{{X: 1, Y: 2}, {X: 3, Y: 6}})
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if got := s.Eval(2.5); math.Abs(got-5) > 1e-14 {
+			t.Errorf("Eval(2.5) = %v, want 5", got)
+		}
+	})
+
+	t.Run("wrong input", func(t *testing.T) {
+		for name, points := range map[string][]numericalanalysis.Point2D{
+			"one point":   {{X: 0, Y: 1}},
+			"duplicate x": {{X: 0, Y: 1}, {X: 1, Y: 2}, {X: 1, Y: 3}},
+		} {
+			if _, err := numericalanalysis.NewPCHIP(points); !errors.Is(err, numericalanalysis.ErrWrongInput) {
+				t.Errorf("%s: err = %v, want %v", name, err, numericalanalysis.ErrWrongInput)
+			}
+		}
+	})
+}
+
+func TestNewAkima(t *testing.T) {
+	t.Run("reproduces a line and follows flat parts", func(t *testing.T) {
+		points := []numericalanalysis.Point2D{
+			{X: 0, Y: 1}, {X: 1, Y: 1}, {X: 2, Y: 1}, {X: 3, Y: 1}, {X: 4, Y: 3}, {X: 5, Y: 5}, {X: 6, Y: 7},
+		}
+		s, err := numericalanalysis.NewAkima(points)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		// No wiggles on the flat and the linear part
+		for _, x := range []float64{0.3, 1.5} {
+			if got := s.Eval(x); math.Abs(got-1) > 1e-14 {
+				t.Errorf("Eval(%v) = %v, want 1", x, got)
+			}
+		}
+		for _, x := range []float64{4.2, 5.5} {
+			if got := s.Eval(x); math.Abs(got-(2*x-5)) > 1e-14 {
+				t.Errorf("Eval(%v) = %v, want %v", x, got, 2*x-5)
+			}
+			if got := s.Derivative(x); math.Abs(got-2) > 1e-14 {
+				t.Errorf("Derivative(%v) = %v, want 2", x, got)
+			}
+		}
+	})
+
+	t.Run("converges for smooth data", func(t *testing.T) {
+		points := grid(math.Exp, 0, 1, 60)
+		s, err := numericalanalysis.NewAkima(points)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		for _, p := range points {
+			if got := s.Eval(p.X); math.Abs(got-p.Y) > 1e-14 {
+				t.Errorf("Eval(%v) = %v, want %v", p.X, got, p.Y)
+			}
+		}
+		for x := 0.05; x < 1; x += 0.03 {
+			if got := s.Eval(x); math.Abs(got-math.Exp(x)) > 1e-4 {
+				t.Errorf("Eval(%v) = %v, want %v", x, got, math.Exp(x))
+			}
+		}
+	})
+
+	t.Run("wrong input", func(t *testing.T) {
+		if _, err := numericalanalysis.NewAkima(nil); !errors.Is(err, numericalanalysis.ErrWrongInput) {
+			t.Errorf("err = %v, want %v", err, numericalanalysis.ErrWrongInput)
+		}
+	})
+}
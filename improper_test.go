@@ -0,0 +1,112 @@
+package numericalanalysis_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	numericalanalysis "github.com/Russia9/numerical-analysis"
+)
+
+func TestIntegralTanhSinh(t *testing.T) {
+	tests := map[string]struct {
+		f        numericalanalysis.Func1D
+		a, b     float64
+		expected float64
+		tol      float64
+	}{
+		// ∫[0,1] 1/√x dx = 2
+		"inverse square root singularity": {f: func(x float64) float64 { return 1 / math.Sqrt(x) }, a: 0, b: 1, expected: 2},
+		// ∫[0,1] ln x dx = -1
+		"logarithmic singularity": {f: math.Log, a: 0, b: 1, expected: -1},
+		// ∫[-1,1] 1/√(1-x²) dx = π, singularities at non-zero endpoints are limited by the rounding of x
+		"singularities at both ends": {f: func(x float64) float64 { return 1 / math.Sqrt(1-x*x) }, a: -1, b: 1, expected: math.Pi, tol: 1e-7},
+		// ∫[1,3] 1/√(x-1) dx = 2√2
+		"shifted singularity": {f: func(x float64) float64 { return 1 / math.Sqrt(x-1) }, a: 1, b: 3, expected: 2 * math.Sqrt2, tol: 1e-7},
+		// ∫[0,π] sin x dx = 2
+		"smooth function": {f: math.Sin, a: 0, b: math.Pi, expected: 2},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			tol := test.tol
+			if tol == 0 {
+				tol = 1e-10
+			}
+			res, err := numericalanalysis.IntegralTanhSinh(test.f, test.a, test.b, tol, 0, 10)
+			if err != nil {
+				t.Fatalf("err = %v, want nil", err)
+			}
+			if math.Abs(res.Value-test.expected) > 10*tol {
+				t.Errorf("Value = %v, want ~%v (error estimate %v)", res.Value, test.expected, res.Error)
+			}
+		})
+	}
+
+	t.Run("reversed limits", func(t *testing.T) {
+		res, err := numericalanalysis.IntegralTanhSinh(math.Log, 1, 0, 1e-10, 0, 10)
+		if err != nil || math.Abs(res.Value-1) > 1e-9 {
+			t.Errorf("result = %+v, err = %v, want 1", res, err)
+		}
+	})
+
+	t.Run("did not converge", func(t *testing.T) {
+		f := func(x float64) float64 { return math.Sin(50 * x) }
+		_, err := numericalanalysis.IntegralTanhSinh(f, 0, 10, 1e-14, 0, 1)
+		if !errors.Is(err, numericalanalysis.ErrDidNotConverge) {
+			t.Errorf("err = %v, want ErrDidNotConverge", err)
+		}
+	})
+
+	t.Run("input validation", func(t *testing.T) {
+		if _, err := numericalanalysis.IntegralTanhSinh(math.Sin, 0, math.Inf(1), 1e-10, 0, 10); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+		if _, err := numericalanalysis.IntegralTanhSinh(math.Sin, 0, 1, 0, 0, 10); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+}
+
+func TestIntegralImproper(t *testing.T) {
+	inf := math.Inf(1)
+
+	tests := map[string]struct {
+		f        numericalanalysis.Func1D
+		a, b     float64
+		expected float64
+	}{
+		// ∫ e^(-x²) dx = √π
+		"gaussian over the real line": {f: func(x float64) float64 { return math.Exp(-x * x) }, a: -inf, b: inf, expected: math.Sqrt(math.Pi)},
+		// ∫ 1/(1+x²) dx = π
+		"algebraic decay over the real line": {f: func(x float64) float64 { return 1 / (1 + x*x) }, a: -inf, b: inf, expected: math.Pi},
+		// ∫[1,∞) 1/x² dx = 1
+		"upper infinite limit": {f: func(x float64) float64 { return 1 / (x * x) }, a: 1, b: inf, expected: 1},
+		// ∫(-∞,0] e^x dx = 1
+		"lower infinite limit": {f: math.Exp, a: -inf, b: 0, expected: 1},
+		// ∫[0,∞) e^(-x)/√x dx = √π, singular endpoint and infinite range
+		"singularity and infinite range": {f: func(x float64) float64 { return math.Exp(-x) / math.Sqrt(x) }, a: 0, b: inf, expected: math.Sqrt(math.Pi)},
+		// ∫[0,1] ln x dx = -1
+		"finite range": {f: math.Log, a: 0, b: 1, expected: -1},
+		// ∫[∞,0] e^(-x) dx = -1
+		"reversed limits": {f: func(x float64) float64 { return math.Exp(-x) }, a: inf, b: 0, expected: -1},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := numericalanalysis.IntegralImproper(test.f, test.a, test.b, 1e-10, 0, 12)
+			if err != nil {
+				t.Fatalf("err = %v, want nil", err)
+			}
+			if math.Abs(res.Value-test.expected) > 1e-8 {
+				t.Errorf("Value = %v, want ~%v (error estimate %v)", res.Value, test.expected, res.Error)
+			}
+		})
+	}
+
+	t.Run("input validation - same infinite limits", func(t *testing.T) {
+		if _, err := numericalanalysis.IntegralImproper(math.Exp, inf, inf, 1e-10, 0, 10); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+}
@@ -25,3 +25,94 @@ func IntegralChebychev(f func(float64) float64, a, b float64, N int) float64 {
 	res *= C_i * (b - a) / 2
 	return res
 }
+
+// IntegralSimpson calculates the integral of a function f(x) using the composite Simpson 1/3 rule.
+// N: number of subintervals, must be even
+func IntegralSimpson(f func(float64) float64, a, b float64, N int) (float64, error) {
+	if N < 2 || N%2 != 0 {
+		return 0, ErrWrongInput
+	}
+	h := (b - a) / float64(N)
+	res := f(a) + f(b)
+	for i := 1; i < N; i++ {
+		x := a + float64(i)*h
+		if i%2 == 1 {
+			res += 4 * f(x)
+		} else {
+			res += 2 * f(x)
+		}
+	}
+	res *= h / 3
+	return res, nil
+}
+
+// IntegralSimpson38 calculates the integral of a function f(x) using the composite Simpson 3/8 rule.
+// N: number of subintervals, must be a multiple of 3
+func IntegralSimpson38(f func(float64) float64, a, b float64, N int) (float64, error) {
+	if N < 3 || N%3 != 0 {
+		return 0, ErrWrongInput
+	}
+	h := (b - a) / float64(N)
+	res := f(a) + f(b)
+	for i := 1; i < N; i++ {
+		x := a + float64(i)*h
+		if i%3 == 0 {
+			res += 2 * f(x)
+		} else {
+			res += 3 * f(x)
+		}
+	}
+	res *= 3 * h / 8
+	return res, nil
+}
+
+// RombergResult is the outcome of Romberg integration
+type RombergResult struct {
+	Value float64     // Integral estimate, the last diagonal element of the table
+	Error float64     // Difference of the last two diagonal elements
+	Evals int         // Number of function evaluations
+	Table [][]float64 // Table[i][j]: trapezoid rule with 2^i subintervals extrapolated j times
+}
+
+// IntegralRomberg calculates the integral of a function f(x) using Romberg integration:
+// the trapezoid rule with doubling number of subintervals and Richardson extrapolation.
+// On ErrDidNotConverge the result holds the table computed so far.
+// eps: tolerance for the difference of successive diagonal elements
+// maxLevels: maximum number of interval halvings
+func IntegralRomberg(f func(float64) float64, a, b float64, eps float64, maxLevels int) (RombergResult, error) {
+	if eps <= 0 || maxLevels < 1 {
+		return RombergResult{}, ErrWrongInput
+	}
+
+	h := b - a
+	res := RombergResult{Evals: 2}
+	res.Table = [][]float64{{h / 2 * (f(a) + f(b))}}
+
+	for i := 1; i <= maxLevels; i++ {
+		// Trapezoid rule with 2^i subintervals reusing the previous points
+		h /= 2
+		sum := 0.
+		for k := 1; k < 1<<i; k += 2 {
+			sum += f(a + float64(k)*h)
+			res.Evals++
+		}
+		row := make([]float64, i+1)
+		row[0] = res.Table[i-1][0]/2 + h*sum
+
+		// Richardson extrapolation
+		factor := 1.
+		for j := 1; j <= i; j++ {
+			factor *= 4
+			row[j] = row[j-1] + (row[j-1]-res.Table[i-1][j-1])/(factor-1)
+		}
+		res.Table = append(res.Table, row)
+
+		res.Value = row[i]
+		res.Error = math.Abs(row[i] - res.Table[i-1][i-1])
+		if res.Error < eps {
+			return res, nil
+		}
+	}
+
+	return res, ErrDidNotConverge
+}
@@ -626,3 +626,97 @@ func TestIntegralTrapezoid(t *testing.T) {
 		}
 	})
 }
+
+func TestIntegralSimpson(t *testing.T) {
+	t.Run("exact for cubics", func(t *testing.T) {
+		// ∫[0,2] x^3 - x dx = 4 - 2 = 2
+		f := func(x float64) float64 { return x*x*x - x }
+
+		result, err := numericalanalysis.IntegralSimpson(f, 0, 2, 2)
+		if err != nil || math.Abs(result-2) > 1e-14 {
+			t.Errorf("IntegralSimpson = %v, %v, want 2", result, err)
+		}
+		result, err = numericalanalysis.IntegralSimpson38(f, 0, 2, 3)
+		if err != nil || math.Abs(result-2) > 1e-14 {
+			t.Errorf("IntegralSimpson38 = %v, %v, want 2", result, err)
+		}
+	})
+
+	t.Run("fourth order convergence", func(t *testing.T) {
+		// ∫[0,π] sin x dx = 2, halving h reduces the error 16 times
+		e1, _ := numericalanalysis.IntegralSimpson(math.Sin, 0, math.Pi, 8)
+		e2, _ := numericalanalysis.IntegralSimpson(math.Sin, 0, math.Pi, 16)
+		if ratio := (e1 - 2) / (e2 - 2); math.Abs(ratio-16) > 0.5 {
+			t.Errorf("error ratio = %v, want ~16", ratio)
+		}
+
+		e1, _ = numericalanalysis.IntegralSimpson38(math.Sin, 0, math.Pi, 9)
+		e2, _ = numericalanalysis.IntegralSimpson38(math.Sin, 0, math.Pi, 18)
+		if ratio := (e1 - 2) / (e2 - 2); math.Abs(ratio-16) > 0.5 {
+			t.Errorf("3/8 error ratio = %v, want ~16", ratio)
+		}
+	})
+
+	t.Run("input validation", func(t *testing.T) {
+		if _, err := numericalanalysis.IntegralSimpson(math.Sin, 0, 1, 3); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("IntegralSimpson err = %v, want ErrWrongInput", err)
+		}
+		if _, err := numericalanalysis.IntegralSimpson38(math.Sin, 0, 1, 4); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("IntegralSimpson38 err = %v, want ErrWrongInput", err)
+		}
+	})
+}
+
+func TestIntegralRomberg(t *testing.T) {
+	t.Run("exponential", func(t *testing.T) {
+		// ∫[0,1] e^x dx = e - 1
+		result, err := numericalanalysis.IntegralRomberg(math.Exp, 0, 1, 1e-12, 20)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(result.Value-(math.E-1)) > 1e-12 {
+			t.Errorf("Value = %v, want ~%v", result.Value, math.E-1)
+		}
+
+		// Table is triangular, the first column is the trapezoid rule
+		levels := len(result.Table) - 1
+		if result.Evals != 1<<levels+1 {
+			t.Errorf("Evals = %v, want %v", result.Evals, 1<<levels+1)
+		}
+		for i, row := range result.Table {
+			if len(row) != i+1 {
+				t.Fatalf("len(Table[%d]) = %v, want %v", i, len(row), i+1)
+			}
+			if trapezoid := numericalanalysis.IntegralTrapezoid(math.Exp, 0, 1, 1<<i); math.Abs(row[0]-trapezoid) > 1e-14 {
+				t.Errorf("Table[%d][0] = %v, want %v", i, row[0], trapezoid)
+			}
+		}
+	})
+
+	t.Run("second column is Simpson's rule", func(t *testing.T) {
+		result, err := numericalanalysis.IntegralRomberg(math.Sin, 0, math.Pi, 1e-10, 20)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		simpson, _ := numericalanalysis.IntegralSimpson(math.Sin, 0, math.Pi, 4)
+		if math.Abs(result.Table[2][1]-simpson) > 1e-14 {
+			t.Errorf("Table[2][1] = %v, want %v", result.Table[2][1], simpson)
+		}
+	})
+
+	t.Run("did not converge", func(t *testing.T) {
+		result, err := numericalanalysis.IntegralRomberg(math.Sqrt, 0, 1, 1e-15, 3)
+		if err != numericalanalysis.ErrDidNotConverge {
+			t.Errorf("err = %v, want ErrDidNotConverge", err)
+		}
+		if len(result.Table) != 4 {
+			t.Errorf("len(Table) = %v, want 4", len(result.Table))
+		}
+	})
+
+	t.Run("input validation", func(t *testing.T) {
+		if _, err := numericalanalysis.IntegralRomberg(math.Sin, 0, 1, 0, 10); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+}
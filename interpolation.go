@@ -1,6 +1,10 @@
 package numericalanalysis
 
-import "sort"
+import (
+	"math"
+	"sort"
+	"sync/atomic"
+)
 
 func LagrangeInterpolation1D(points []Point2D) Func1D {
 	n := len(points)
@@ -31,105 +35,274 @@ func LagrangeInterpolation1D(points []Point2D) Func1D {
 }
 
 func LinearInterpolation1D(points []Point2D) Func1D {
-	n := len(points)
-
-	// Sort points by X
-	sort.Slice(points, func(i, j int) bool {
-		return points[i].X < points[j].X
-	})
-
-	return func(x float64) float64 {
-		i := 0
-		if x < points[0].X {
-			i = 0
-		} else if x >= points[n-1].X {
-			i = n - 2
-		} else {
-			for i = range n - 1 {
-				if points[i].X <= x && x < points[i+1].X {
-					break
-				}
-			}
-		}
-
-		return points[i].Y + (points[i+1].Y-points[i].Y)/(points[i+1].X-points[i].X)*(x-points[i].X)
+	x, y, err := sortedPoints(points)
+	if err != nil {
+		return nil
 	}
+	return newLinearInterpolator(x, y).Eval
 }
 
 func BilinearInterpolation2D(points []Point3D) Func2D {
-	// Convert points to map[y]Point2D(x,z)
+	// Group the points into rows of equal Y
 	rows := map[float64][]Point2D{}
-	var yValues []float64
 	for _, pt := range points {
 		rows[pt.Y] = append(rows[pt.Y], Point2D{X: pt.X, Y: pt.Z})
 	}
+	if len(rows) < 2 {
+		return nil
+	}
 
-	// Sort Y values
+	// Sort Y values and precompute the interpolant of every row
+	yValues := make([]float64, 0, len(rows))
 	for y := range rows {
 		yValues = append(yValues, y)
 	}
 	sort.Float64s(yValues)
-
-	// Sort each row by X
-	for _, row := range rows {
-		sort.Slice(row, func(i, j int) bool {
-			return row[i].X < row[j].X
-		})
+	lines := make([]*linearInterpolator, len(yValues))
+	for i, y := range yValues {
+		x, z, err := sortedPoints(rows[y])
+		if err != nil {
+			return nil
+		}
+		lines[i] = newLinearInterpolator(x, z)
 	}
+	cache := &intervalCache{x: yValues}
 
 	return func(x, y float64) float64 {
-		// Find bounding Y values
-		var y0, y1 float64
-		switch {
-		case y <= yValues[0]:
-			y0, y1 = yValues[0], yValues[1]
-		case y >= yValues[len(yValues)-1]:
-			y0, y1 = yValues[len(yValues)-2], yValues[len(yValues)-1]
-		default:
-			for i := range len(yValues) - 1 {
-				if yValues[i] <= y && y < yValues[i+1] {
-					y0, y1 = yValues[i], yValues[i+1]
-					break
-				}
-			}
-		}
-
-		// Interpolate along x for each y-row
-		f0 := LinearInterpolation1D(rows[y0])
-		f1 := LinearInterpolation1D(rows[y1])
-
-		z0 := f0(x)
-		z1 := f1(x)
-
-		// Interpolate along y between z0 and z1
+		// Interpolate along x in the bounding rows, then along y
+		i := cache.find(y)
+		y0, y1 := yValues[i], yValues[i+1]
+		z0, z1 := lines[i].Eval(x), lines[i+1].Eval(x)
 		return z0 + (z1-z0)*(y-y0)/(y1-y0)
 	}
 }
 
 func QuadraticInterpolation1D(points []Point2D) Func1D {
-	n := len(points)
-	if n < 3 {
+	if len(points) < 3 {
 		return nil
 	}
+	x, y, err := sortedPoints(points)
+	if err != nil {
+		return nil
+	}
+	return newQuadraticInterpolator(x, y).Eval
+}
+
+// Interpolator is an interpolant of one-dimensional data.
+// Its Eval method can be used wherever a Func1D is expected.
+// NewLinearInterpolator and NewQuadraticInterpolator take an extrapolation policy, Extrapolate applies one to the
+// other interpolants: CubicSpline, PCHIP and Akima HermiteSpline, Barycentric and NewtonPolynomial.
+// Without a policy Eval continues the nearest piece outside the domain and EvalErr returns ErrOutOfDomain there.
+type Interpolator interface {
+	Eval(x float64) float64             // Value at x
+	EvalErr(x float64) (float64, error) // Value at x, or NaN and ErrOutOfDomain where the extrapolation policy gives no value
+	Derivative(x float64) float64       // First derivative at x
+	Domain() (float64, float64)         // Smallest and largest X of the data
+}
+
+// ExtrapolationMode is a way of evaluating an interpolant outside its domain
+type ExtrapolationMode int
+
+const (
+	ExtrapolateError    ExtrapolationMode = iota // ErrOutOfDomain from EvalErr and NaN from Eval outside the domain
+	ExtrapolateClamp                             // Value at the nearest end of the domain
+	ExtrapolateLinear                            // Tangent line at the nearest end of the domain
+	ExtrapolateConstant                          // Extrapolation.Value
+)
+
+// Extrapolation is a policy for evaluating an interpolant outside its domain, the zero value is ExtrapolateError
+type Extrapolation struct {
+	Mode  ExtrapolationMode
+	Value float64 // Value outside the domain for ExtrapolateConstant
+}
+
+// Extrapolate wraps the interpolant so that it follows the extrapolation policy outside its domain
+func Extrapolate(interp Interpolator, extrapolation Extrapolation) (Interpolator, error) {
+	// Check input
+	if interp == nil || extrapolation.Mode < ExtrapolateError || extrapolation.Mode > ExtrapolateConstant {
+		return nil, ErrWrongInput
+	}
 
-	return func(x float64) float64 {
-		i := 1
-		if x < points[1].X {
-			i = 1
-		} else if x >= points[n-2].X {
-			i = n - 2
-		} else {
-			// Find center point of the point region
-			for j := 1; j < n-1; j += 2 {
-				if points[j-1].X <= x && x < points[j+1].X {
-					i = j
-					break
-				}
-			}
+	lo, hi := interp.Domain()
+	return &extrapolated{Interpolator: interp, extrapolation: extrapolation, lo: lo, hi: hi}, nil
+}
+
+// NewLinearInterpolator builds the piecewise linear interpolant of the points.
+// The points do not have to be sorted, but there must be at least two and their X must be distinct.
+func NewLinearInterpolator(points []Point2D, extrapolation Extrapolation) (Interpolator, error) {
+	x, y, err := sortedPoints(points)
+	if err != nil {
+		return nil, err
+	}
+	return Extrapolate(newLinearInterpolator(x, y), extrapolation)
+}
+
+// NewQuadraticInterpolator builds the piecewise quadratic interpolant of the points: a parabola through every
+// three consecutive points, the last parabola of an even count passes through the last three points.
+// The points do not have to be sorted, but there must be at least three and their X must be distinct.
+func NewQuadraticInterpolator(points []Point2D, extrapolation Extrapolation) (Interpolator, error) {
+	// Check input
+	if len(points) < 3 {
+		return nil, ErrWrongInput
+	}
+
+	x, y, err := sortedPoints(points)
+	if err != nil {
+		return nil, err
+	}
+	return Extrapolate(newQuadraticInterpolator(x, y), extrapolation)
+}
+
+// evalInDomain evaluates the interpolant at x, or returns ErrOutOfDomain outside its domain
+func evalInDomain(interp Interpolator, x float64) (float64, error) {
+	lo, hi := interp.Domain()
+	if !(x >= lo && x <= hi) {
+		return math.NaN(), ErrOutOfDomain
+	}
+	return interp.Eval(x), nil
+}
+
+// extrapolated applies an extrapolation policy to an interpolant
+type extrapolated struct {
+	Interpolator
+	extrapolation Extrapolation
+	lo, hi        float64 // Domain
+}
+
+func (e *extrapolated) Eval(x float64) float64 {
+	if x >= e.lo && x <= e.hi {
+		return e.Interpolator.Eval(x)
+	}
+
+	end := e.lo
+	if x > e.hi {
+		end = e.hi
+	}
+	switch e.extrapolation.Mode {
+	case ExtrapolateClamp:
+		return e.Interpolator.Eval(end)
+	case ExtrapolateLinear:
+		return e.Interpolator.Eval(end) + e.Interpolator.Derivative(end)*(x-end)
+	case ExtrapolateConstant:
+		return e.extrapolation.Value
+	}
+	return math.NaN()
+}
+
+func (e *extrapolated) EvalErr(x float64) (float64, error) {
+	if e.extrapolation.Mode == ExtrapolateError && !(x >= e.lo && x <= e.hi) {
+		return math.NaN(), ErrOutOfDomain
+	}
+	return e.Eval(x), nil
+}
+
+func (e *extrapolated) Derivative(x float64) float64 {
+	if x >= e.lo && x <= e.hi {
+		return e.Interpolator.Derivative(x)
+	}
+
+	switch e.extrapolation.Mode {
+	case ExtrapolateClamp, ExtrapolateConstant:
+		return 0
+	case ExtrapolateLinear:
+		if x > e.hi {
+			return e.Interpolator.Derivative(e.hi)
 		}
+		return e.Interpolator.Derivative(e.lo)
+	}
+	return math.NaN()
+}
+
+// linearInterpolator is a piecewise linear interpolant of sorted points
+type linearInterpolator struct {
+	x, y  []float64
+	cache intervalCache
+}
+
+func newLinearInterpolator(x, y []float64) *linearInterpolator {
+	return &linearInterpolator{x: x, y: y, cache: intervalCache{x: x}}
+}
+
+func (l *linearInterpolator) Eval(x float64) float64 {
+	i := l.cache.find(x)
+	return l.y[i] + (l.y[i+1]-l.y[i])/(l.x[i+1]-l.x[i])*(x-l.x[i])
+}
+
+func (l *linearInterpolator) EvalErr(x float64) (float64, error) {
+	return evalInDomain(l, x)
+}
+
+// Derivative returns the slope of the segment containing x, the right one at the points
+func (l *linearInterpolator) Derivative(x float64) float64 {
+	i := l.cache.find(x)
+	if i < len(l.x)-2 && x == l.x[i+1] {
+		i++
+	}
+	return (l.y[i+1] - l.y[i]) / (l.x[i+1] - l.x[i])
+}
+
+func (l *linearInterpolator) Domain() (float64, float64) {
+	return l.x[0], l.x[len(l.x)-1]
+}
+
+// quadraticInterpolator is a piecewise quadratic interpolant of sorted points
+type quadraticInterpolator struct {
+	x, y  []float64
+	cache intervalCache
+}
+
+func newQuadraticInterpolator(x, y []float64) *quadraticInterpolator {
+	return &quadraticInterpolator{x: x, y: y, cache: intervalCache{x: x}}
+}
+
+// first returns the index of the first of the three points whose parabola is used at x
+func (q *quadraticInterpolator) first(x float64) int {
+	i := q.cache.find(x)
+	return min(i-i%2, len(q.x)-3)
+}
+
+func (q *quadraticInterpolator) Eval(x float64) float64 {
+	k := q.first(x)
+	x0, x1, x2 := q.x[k], q.x[k+1], q.x[k+2]
+	return q.y[k]*(x-x1)*(x-x2)/((x0-x1)*(x0-x2)) +
+		q.y[k+1]*(x-x0)*(x-x2)/((x1-x0)*(x1-x2)) +
+		q.y[k+2]*(x-x0)*(x-x1)/((x2-x0)*(x2-x1))
+}
+
+func (q *quadraticInterpolator) EvalErr(x float64) (float64, error) {
+	return evalInDomain(q, x)
+}
+
+func (q *quadraticInterpolator) Derivative(x float64) float64 {
+	k := q.first(x)
+	x0, x1, x2 := q.x[k], q.x[k+1], q.x[k+2]
+	return q.y[k]*(2*x-x1-x2)/((x0-x1)*(x0-x2)) +
+		q.y[k+1]*(2*x-x0-x2)/((x1-x0)*(x1-x2)) +
+		q.y[k+2]*(2*x-x0-x1)/((x2-x0)*(x2-x1))
+}
+
+func (q *quadraticInterpolator) Domain() (float64, float64) {
+	return q.x[0], q.x[len(q.x)-1]
+}
+
+// intervalCache finds the interval of sorted nodes containing a value like findInterval, but tries the interval
+// of the previous lookup and its right neighbour before the binary search. It is safe for concurrent use.
+type intervalCache struct {
+	x    []float64
+	last atomic.Int64
+}
 
-		return points[i-1].Y*(x-points[i].X)*(x-points[i+1].X)/((points[i-1].X-points[i].X)*(points[i-1].X-points[i+1].X)) +
-			points[i].Y*(x-points[i-1].X)*(x-points[i+1].X)/((points[i].X-points[i-1].X)*(points[i].X-points[i+1].X)) +
-			points[i+1].Y*(x-points[i-1].X)*(x-points[i].X)/((points[i+1].X-points[i-1].X)*(points[i+1].X-points[i].X))
+func (c *intervalCache) find(v float64) int {
+	x := c.x
+	i := int(c.last.Load())
+	switch {
+	case x[i] < v && v <= x[i+1]:
+		return i
+	case i+2 < len(x) && x[i+1] < v && v <= x[i+2]:
+		i++
+	default:
+		i = findInterval(x, v)
 	}
+	c.last.Store(int64(i))
+	return i
 }
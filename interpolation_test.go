@@ -0,0 +1,288 @@
+package numericalanalysis_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"sync"
+	"testing"
+
+	numericalanalysis "github.com/Russia9/numerical-analysis"
+)
+
+func TestLinearInterpolation1D(t *testing.T) {
+	t.Run("random lookups", func(t *testing.T) {
+		// Linear interpolation of |x| is exact except in the interval containing the kink at 0
+		points := samples(math.Abs, -5, 5, 50, 12)
+		f := numericalanalysis.LinearInterpolation1D(points)
+		rng := rand.New(rand.NewSource(13))
+		for range 1000 {
+			x := -5 + 10*rng.Float64()
+			if got := f(x); math.Abs(got-math.Abs(x)) > 0.2 {
+				t.Fatalf("f(%v) = %v, want %v", x, got, math.Abs(x))
+			}
+		}
+		for _, p := range points {
+			if got := f(p.X); math.Abs(got-p.Y) > 1e-14 {
+				t.Errorf("f(%v) = %v, want %v", p.X, got, p.Y)
+			}
+		}
+	})
+
+	t.Run("does not modify the points", func(t *testing.T) {
+		points := []numericalanalysis.Point2D{{X: 2, Y: 4}, {X: 0, Y: 0}, {X: 1, Y: 2}}
+		f := numericalanalysis.LinearInterpolation1D(points)
+		if got := f(1.5); got != 3 {
+			t.Errorf("f(1.5) = %v, want 3", got)
+		}
+		if points[0].X != 2 {
+			t.Errorf("points were reordered: %v", points)
+		}
+	})
+
+	t.Run("wrong input", func(t *testing.T) {
+		if f := numericalanalysis.LinearInterpolation1D([]numericalanalysis.Point2D{{X: 0, Y: 0}}); f != nil {
+			t.Errorf("f = %p, want nil", f)
+		}
+	})
+}
+
+func TestQuadraticInterpolation1D(t *testing.T) {
+	t.Run("unsorted points of a parabola", func(t *testing.T) {
+		f := func(x float64) float64 { return x*x - 3*x }
+		q := numericalanalysis.QuadraticInterpolation1D(samples(f, 0, 4, 9, 14))
+		for x := -1.; x <= 5; x += 0.25 {
+			if got := q(x); math.Abs(got-f(x)) > 1e-12 {
+				t.Errorf("q(%v) = %v, want %v", x, got, f(x))
+			}
+		}
+	})
+
+	t.Run("wrong input", func(t *testing.T) {
+		if q := numericalanalysis.QuadraticInterpolation1D([]numericalanalysis.Point2D{{X: 0, Y: 0}, {X: 1, Y: 1}}); q != nil {
+			t.Errorf("q = %p, want nil", q)
+		}
+	})
+}
+
+func TestBilinearInterpolation2D(t *testing.T) {
+	f := func(x, y float64) float64 { return 1 + 2*x + 3*y + x*y }
+	var points []numericalanalysis.Point3D
+	for _, y := range []float64{3, -1, 0, 2} {
+		for _, x := range []float64{0.5, -2, 1, 0} {
+			points = append(points, numericalanalysis.Point3D{X: x, Y: y, Z: f(x, y)})
+		}
+	}
+	g := numericalanalysis.BilinearInterpolation2D(points)
+
+	t.Run("reproduces a bilinear function", func(t *testing.T) {
+		for x := -2.; x <= 1; x += 0.1 {
+			for y := -1.; y <= 3; y += 0.1 {
+				if got := g(x, y); math.Abs(got-f(x, y)) > 1e-12 {
+					t.Fatalf("g(%v, %v) = %v, want %v", x, y, got, f(x, y))
+				}
+			}
+		}
+	})
+
+	t.Run("concurrent lookups", func(t *testing.T) {
+		var wg sync.WaitGroup
+		errs := make([]error, 4)
+		for w := range errs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				rng := rand.New(rand.NewSource(int64(w)))
+				for range 1000 {
+					x, y := -2+3*rng.Float64(), -1+4*rng.Float64()
+					if got := g(x, y); math.Abs(got-f(x, y)) > 1e-12 {
+						errs[w] = errors.New("wrong value")
+						return
+					}
+				}
+			}()
+		}
+		wg.Wait()
+		for w, err := range errs {
+			if err != nil {
+				t.Errorf("worker %d: %v", w, err)
+			}
+		}
+	})
+
+	t.Run("wrong input", func(t *testing.T) {
+		if g := numericalanalysis.BilinearInterpolation2D([]numericalanalysis.Point3D{{X: 0, Y: 0}, {X: 1, Y: 0}}); g != nil {
+			t.Errorf("g = %p, want nil", g)
+		}
+	})
+}
+
+func TestNewLinearInterpolator(t *testing.T) {
+	points := []numericalanalysis.Point2D{{X: 3, Y: 1}, {X: 0, Y: 1}, {X: 1, Y: 3}}
+
+	t.Run("interpolates without modifying the points", func(t *testing.T) {
+		l, err := numericalanalysis.NewLinearInterpolator(points, numericalanalysis.Extrapolation{})
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if points[0].X != 3 || points[1].X != 0 {
+			t.Errorf("points were reordered: %v", points)
+		}
+		tests := []struct{ x, want, deriv float64 }{{0, 1, 2}, {0.5, 2, 2}, {1, 3, -1}, {2, 2, -1}, {3, 1, -1}}
+		for _, test := range tests {
+			if got := l.Eval(test.x); math.Abs(got-test.want) > 1e-15 {
+				t.Errorf("Eval(%v) = %v, want %v", test.x, got, test.want)
+			}
+			if got := l.Derivative(test.x); math.Abs(got-test.deriv) > 1e-15 {
+				t.Errorf("Derivative(%v) = %v, want %v", test.x, got, test.deriv)
+			}
+		}
+		if lo, hi := l.Domain(); lo != 0 || hi != 3 {
+			t.Errorf("Domain() = %v, %v, want 0, 3", lo, hi)
+		}
+	})
+
+	t.Run("extrapolation policies", func(t *testing.T) {
+		tests := map[string]struct {
+			extrapolation      numericalanalysis.Extrapolation
+			left, right, dleft float64
+		}{
+			"clamp":    {extrapolation: numericalanalysis.Extrapolation{Mode: numericalanalysis.ExtrapolateClamp}, left: 1, right: 1, dleft: 0},
+			"linear":   {extrapolation: numericalanalysis.Extrapolation{Mode: numericalanalysis.ExtrapolateLinear}, left: -1, right: 0, dleft: 2},
+			"constant": {extrapolation: numericalanalysis.Extrapolation{Mode: numericalanalysis.ExtrapolateConstant, Value: 7}, left: 7, right: 7, dleft: 0},
+		}
+		for name, test := range tests {
+			l, err := numericalanalysis.NewLinearInterpolator(points, test.extrapolation)
+			if err != nil {
+				t.Fatalf("%s: err = %v, want nil", name, err)
+			}
+			if got := l.Eval(-1); got != test.left {
+				t.Errorf("%s: Eval(-1) = %v, want %v", name, got, test.left)
+			}
+			if got := l.Eval(4); got != test.right {
+				t.Errorf("%s: Eval(4) = %v, want %v", name, got, test.right)
+			}
+			if got := l.Derivative(-1); got != test.dleft {
+				t.Errorf("%s: Derivative(-1) = %v, want %v", name, got, test.dleft)
+			}
+		}
+
+		l, err := numericalanalysis.NewLinearInterpolator(points, numericalanalysis.Extrapolation{Mode: numericalanalysis.ExtrapolateError})
+		if err != nil {
+			t.Fatalf("error: err = %v, want nil", err)
+		}
+		if got := l.Eval(3.5); !math.IsNaN(got) {
+			t.Errorf("error: Eval(3.5) = %v, want NaN", got)
+		}
+		if got, err := l.EvalErr(3.5); !math.IsNaN(got) || !errors.Is(err, numericalanalysis.ErrOutOfDomain) || !errors.Is(err, numericalanalysis.ErrWrongInput) {
+			t.Errorf("error: EvalErr(3.5) = %v, %v, want NaN, %v", got, err, numericalanalysis.ErrOutOfDomain)
+		}
+		if got, err := l.EvalErr(0.5); got != 2 || err != nil {
+			t.Errorf("error: EvalErr(0.5) = %v, %v, want 2, nil", got, err)
+		}
+	})
+
+	t.Run("wrong input", func(t *testing.T) {
+		tests := map[string]struct {
+			points        []numericalanalysis.Point2D
+			extrapolation numericalanalysis.Extrapolation
+		}{
+			"one point":    {points: []numericalanalysis.Point2D{{X: 0, Y: 1}}},
+			"duplicate x":  {points: []numericalanalysis.Point2D{{X: 0, Y: 1}, {X: 0, Y: 2}}},
+			"unknown mode": {points: points, extrapolation: numericalanalysis.Extrapolation{Mode: 9}},
+		}
+		for name, test := range tests {
+			if _, err := numericalanalysis.NewLinearInterpolator(test.points, test.extrapolation); !errors.Is(err, numericalanalysis.ErrWrongInput) {
+				t.Errorf("%s: err = %v, want %v", name, err, numericalanalysis.ErrWrongInput)
+			}
+		}
+	})
+}
+
+func TestNewQuadraticInterpolator(t *testing.T) {
+	t.Run("reproduces a parabola", func(t *testing.T) {
+		f := func(x float64) float64 { return 3*x*x - x + 2 }
+		q, err := numericalanalysis.NewQuadraticInterpolator(samples(f, -1, 2, 6, 11), numericalanalysis.Extrapolation{})
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		for x := -1.; x <= 2; x += 0.1 {
+			if got := q.Eval(x); math.Abs(got-f(x)) > 1e-12 {
+				t.Errorf("Eval(%v) = %v, want %v", x, got, f(x))
+			}
+			if got := q.Derivative(x); math.Abs(got-(6*x-1)) > 1e-11 {
+				t.Errorf("Derivative(%v) = %v, want %v", x, got, 6*x-1)
+			}
+		}
+	})
+
+	t.Run("wrong input", func(t *testing.T) {
+		points := []numericalanalysis.Point2D{{X: 0, Y: 1}, {X: 1, Y: 2}}
+		if _, err := numericalanalysis.NewQuadraticInterpolator(points, numericalanalysis.Extrapolation{}); !errors.Is(err, numericalanalysis.ErrWrongInput) {
+			t.Errorf("err = %v, want %v", err, numericalanalysis.ErrWrongInput)
+		}
+	})
+}
+
+func TestExtrapolate(t *testing.T) {
+	t.Run("spline with linear extrapolation", func(t *testing.T) {
+		s, err := numericalanalysis.NewCubicSpline(grid(math.Sin, 0, 1, 11), numericalanalysis.NaturalSpline, 0, 0)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		e, err := numericalanalysis.Extrapolate(s, numericalanalysis.Extrapolation{Mode: numericalanalysis.ExtrapolateLinear})
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if got, want := e.Eval(0.5), s.Eval(0.5); got != want {
+			t.Errorf("Eval(0.5) = %v, want %v", got, want)
+		}
+		if got, want := e.Eval(1.5), s.Eval(1)+0.5*s.Derivative(1); math.Abs(got-want) > 1e-15 {
+			t.Errorf("Eval(1.5) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("every interpolant is an Interpolator", func(t *testing.T) {
+		points := grid(math.Exp, 0, 1, 5)
+		spline, _ := numericalanalysis.NewCubicSpline(points, numericalanalysis.NotAKnotSpline, 0, 0)
+		pchip, _ := numericalanalysis.NewPCHIP(points)
+		akima, _ := numericalanalysis.NewAkima(points)
+		bary, _ := numericalanalysis.NewBarycentric(points)
+		newton, _ := numericalanalysis.NewNewtonPolynomial(points)
+		for _, interp := range []numericalanalysis.Interpolator{spline, pchip, akima, bary, newton} {
+			if lo, hi := interp.Domain(); lo != 0 || hi != 1 {
+				t.Errorf("%T: Domain() = %v, %v, want 0, 1", interp, lo, hi)
+			}
+			if _, err := interp.EvalErr(2); !errors.Is(err, numericalanalysis.ErrOutOfDomain) {
+				t.Errorf("%T: EvalErr(2) err = %v, want %v", interp, err, numericalanalysis.ErrOutOfDomain)
+			}
+
+			e, err := numericalanalysis.Extrapolate(interp, numericalanalysis.Extrapolation{Mode: numericalanalysis.ExtrapolateClamp})
+			if err != nil {
+				t.Fatalf("%T: err = %v, want nil", interp, err)
+			}
+			if got, err := e.EvalErr(2); math.Abs(got-math.E) > 1e-14 || err != nil {
+				t.Errorf("%T: EvalErr(2) = %v, %v, want %v, nil", interp, got, err, math.E)
+			}
+
+			e, err = numericalanalysis.Extrapolate(interp, numericalanalysis.Extrapolation{Mode: numericalanalysis.ExtrapolateLinear})
+			if err != nil {
+				t.Fatalf("%T: err = %v, want nil", interp, err)
+			}
+			if got, want := e.Eval(-1), interp.Eval(0)-interp.Derivative(0); math.Abs(got-want) > 1e-14 {
+				t.Errorf("%T: Eval(-1) = %v, want %v", interp, got, want)
+			}
+
+			e, err = numericalanalysis.Extrapolate(interp, numericalanalysis.Extrapolation{Mode: numericalanalysis.ExtrapolateError})
+			if err != nil {
+				t.Fatalf("%T: err = %v, want nil", interp, err)
+			}
+			if got, err := e.EvalErr(-1); !math.IsNaN(got) || !errors.Is(err, numericalanalysis.ErrOutOfDomain) {
+				t.Errorf("%T: EvalErr(-1) = %v, %v, want NaN, %v", interp, got, err, numericalanalysis.ErrOutOfDomain)
+			}
+			if got, err := e.EvalErr(0.5); got != interp.Eval(0.5) || err != nil {
+				t.Errorf("%T: EvalErr(0.5) = %v, %v, want %v, nil", interp, got, err, interp.Eval(0.5))
+			}
+		}
+	})
+}
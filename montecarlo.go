@@ -0,0 +1,243 @@
+package numericalanalysis
+
+import (
+	"math"
+	"math/rand"
+)
+
+// montecarlo.go
+// Monte Carlo and quasi-Monte Carlo integration
+
+// IntegralMonteCarlo calculates the integral of f over the box lower <= x <= upper with n uniformly distributed random points.
+// The error estimate is the standard error V·σ/√n.
+// rng: random number generator (seed it for reproducible results)
+func IntegralMonteCarlo(f func(x []float64) float64, lower, upper []float64, n int, rng *rand.Rand) (QuadratureResult, error) {
+	// Check input
+	if err := checkBox(lower, upper); err != nil {
+		return QuadratureResult{}, err
+	}
+	if n < 2 || rng == nil {
+		return QuadratureResult{}, ErrWrongInput
+	}
+	d := len(lower)
+
+	volume := 1.
+	for i := range d {
+		volume *= upper[i] - lower[i]
+	}
+
+	// Welford's algorithm for the mean and the variance
+	x := make([]float64, d)
+	mean, m2 := 0., 0.
+	for k := range n {
+		for i := range d {
+			x[i] = lower[i] + rng.Float64()*(upper[i]-lower[i])
+		}
+		y := f(x)
+		delta := y - mean
+		mean += delta / float64(k+1)
+		m2 += delta * (y - mean)
+	}
+
+	return QuadratureResult{
+		Value: volume * mean,
+		Error: volume * math.Sqrt(m2/float64(n-1)/float64(n)),
+		Evals: n,
+	}, nil
+}
+
+// QMCSequence is a low-discrepancy sequence for quasi-Monte Carlo integration
+type QMCSequence int
+
+const (
+	HaltonSequence QMCSequence = iota // Radical inverses in prime bases, any dimension
+	SobolSequence                     // Sobol sequence with Joe–Kuo direction numbers, up to 10 dimensions
+)
+
+// qmcReplicas is the number of randomly shifted copies of the sequence used for the error estimate
+const qmcReplicas = 8
+
+// IntegralQuasiMonteCarlo calculates the integral of f over the box lower <= x <= upper with n points of a low-discrepancy
+// sequence. The points are split into 8 copies of the sequence, each shifted by a random vector modulo 1
+// (Cranley–Patterson rotation); the result is their mean and the error estimate is its standard error.
+// The Sobol sequence is available up to 10 dimensions, the Halton sequence is used instead in higher dimensions.
+// n: total number of points, at least 16; for the Sobol sequence n/8 should be a power of two
+// rng: random number generator for the shifts (seed it for reproducible results)
+func IntegralQuasiMonteCarlo(f func(x []float64) float64, lower, upper []float64, n int, sequence QMCSequence, rng *rand.Rand) (QuadratureResult, error) {
+	// Check input
+	if err := checkBox(lower, upper); err != nil {
+		return QuadratureResult{}, err
+	}
+	if n < 2*qmcReplicas || rng == nil {
+		return QuadratureResult{}, ErrWrongInput
+	}
+	d := len(lower)
+	m := n / qmcReplicas
+
+	var points [][]float64
+	var err error
+	switch {
+	case sequence == HaltonSequence, sequence == SobolSequence && d > sobolMaxDim:
+		points, err = HaltonPoints(m, d)
+	case sequence == SobolSequence:
+		points, err = SobolPoints(m, d)
+	default:
+		err = ErrWrongInput
+	}
+	if err != nil {
+		return QuadratureResult{}, err
+	}
+
+	volume := 1.
+	for i := range d {
+		volume *= upper[i] - lower[i]
+	}
+
+	x := make([]float64, d)
+	shift := make([]float64, d)
+	means := make([]float64, qmcReplicas)
+	for r := range qmcReplicas {
+		for i := range d {
+			shift[i] = rng.Float64()
+		}
+		sum := 0.
+		for _, p := range points {
+			for i := range d {
+				u := p[i] + shift[i]
+				if u >= 1 {
+					u--
+				}
+				x[i] = lower[i] + u*(upper[i]-lower[i])
+			}
+			sum += f(x)
+		}
+		means[r] = volume * sum / float64(m)
+	}
+
+	res := QuadratureResult{Evals: m * qmcReplicas}
+	for _, v := range means {
+		res.Value += v / qmcReplicas
+	}
+	variance := 0.
+	for _, v := range means {
+		variance += (v - res.Value) * (v - res.Value)
+	}
+	res.Error = math.Sqrt(variance / (qmcReplicas - 1) / qmcReplicas)
+
+	return res, nil
+}
+
+// HaltonPoints returns the first n points of the d-dimensional Halton sequence in [0, 1)^d, starting from index 1
+func HaltonPoints(n, d int) ([][]float64, error) {
+	// Check input
+	if n < 1 || d < 1 {
+		return nil, ErrWrongInput
+	}
+
+	// First d primes
+	primes := make([]int, 0, d)
+	for p := 2; len(primes) < d; p++ {
+		prime := true
+		for _, q := range primes {
+			if q*q > p {
+				break
+			}
+			if p%q == 0 {
+				prime = false
+				break
+			}
+		}
+		if prime {
+			primes = append(primes, p)
+		}
+	}
+
+	points := make([][]float64, n)
+	for k := range n {
+		points[k] = make([]float64, d)
+		for i, b := range primes {
+			// Radical inverse of k+1 in base b
+			inv, f := 0., 1/float64(b)
+			for j := k + 1; j > 0; j /= b {
+				inv += float64(j%b) * f
+				f /= float64(b)
+			}
+			points[k][i] = inv
+		}
+	}
+
+	return points, nil
+}
+
+// sobolMaxDim is the largest dimension supported by SobolPoints
+const sobolMaxDim = 10
+
+// sobolDirections holds the degree s, the coefficients a and the initial numbers m of the primitive
+// polynomials for dimensions 2..sobolMaxDim (Joe and Kuo, new-joe-kuo-6.21201)
+var sobolDirections = [sobolMaxDim - 1]struct {
+	s, a int
+	m    []uint32
+}{
+	{1, 0, []uint32{1}},
+	{2, 1, []uint32{1, 3}},
+	{3, 1, []uint32{1, 3, 1}},
+	{3, 2, []uint32{1, 1, 1}},
+	{4, 1, []uint32{1, 1, 3, 3}},
+	{4, 4, []uint32{1, 3, 5, 13}},
+	{5, 2, []uint32{1, 1, 5, 5, 17}},
+	{5, 4, []uint32{1, 1, 5, 5, 5}},
+	{5, 7, []uint32{1, 1, 7, 11, 19}},
+}
+
+// SobolPoints returns the first n points of the d-dimensional Sobol sequence in [0, 1)^d, starting from the origin.
+// d is at most 10, use HaltonPoints in higher dimensions.
+func SobolPoints(n, d int) ([][]float64, error) {
+	// Check input
+	if n < 1 || d < 1 || d > sobolMaxDim {
+		return nil, ErrWrongInput
+	}
+
+	// Direction numbers V[i][k] for bit k+1
+	const bits = 32
+	V := make([][bits]uint32, d)
+	for k := range bits {
+		V[0][k] = 1 << (bits - 1 - k)
+	}
+	for i := 1; i < d; i++ {
+		dir := sobolDirections[i-1]
+		for k := range bits {
+			if k < dir.s {
+				V[i][k] = dir.m[k] << (bits - 1 - k)
+				continue
+			}
+			v := V[i][k-dir.s] ^ (V[i][k-dir.s] >> dir.s)
+			for l := 1; l < dir.s; l++ {
+				if (dir.a>>(dir.s-1-l))&1 == 1 {
+					v ^= V[i][k-l]
+				}
+			}
+			V[i][k] = v
+		}
+	}
+
+	// Gray code construction
+	X := make([]uint32, d)
+	points := make([][]float64, n)
+	for k := range n {
+		points[k] = make([]float64, d)
+		for i := range d {
+			points[k][i] = float64(X[i]) / (1 << bits)
+		}
+
+		// Index of the rightmost zero bit of k
+		c := 0
+		for j := k; j&1 == 1; j >>= 1 {
+			c++
+		}
+		for i := range d {
+			X[i] ^= V[i][c]
+		}
+	}
+
+	return points, nil
+}
@@ -0,0 +1,157 @@
+package numericalanalysis_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	numericalanalysis "github.com/Russia9/numerical-analysis"
+)
+
+func TestIntegralMonteCarlo(t *testing.T) {
+	// ∫[0,1]^6 Π 2x_i = 1
+	d := 6
+	f := func(x []float64) float64 {
+		res := 1.
+		for i := range x {
+			res *= 2 * x[i]
+		}
+		return res
+	}
+	lower, upper := make([]float64, d), make([]float64, d)
+	for i := range upper {
+		upper[i] = 1
+	}
+
+	t.Run("IntegralMonteCarlo", func(t *testing.T) {
+		res, err := numericalanalysis.IntegralMonteCarlo(f, lower, upper, 100000, rand.New(rand.NewSource(1)))
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if res.Error <= 0 || math.Abs(res.Value-1) > 4*res.Error {
+			t.Errorf("result = %+v, want 1 within the error estimate", res)
+		}
+		if res.Evals != 100000 {
+			t.Errorf("Evals = %v, want 100000", res.Evals)
+		}
+	})
+
+	for name, sequence := range map[string]numericalanalysis.QMCSequence{
+		"halton": numericalanalysis.HaltonSequence,
+		"sobol":  numericalanalysis.SobolSequence,
+	} {
+		t.Run("IntegralQuasiMonteCarlo - "+name, func(t *testing.T) {
+			mc, _ := numericalanalysis.IntegralMonteCarlo(f, lower, upper, 8*4096, rand.New(rand.NewSource(1)))
+			res, err := numericalanalysis.IntegralQuasiMonteCarlo(f, lower, upper, 8*4096, sequence, rand.New(rand.NewSource(2)))
+			if err != nil {
+				t.Fatalf("err = %v, want nil", err)
+			}
+			if res.Error <= 0 || math.Abs(res.Value-1) > 4*res.Error {
+				t.Errorf("result = %+v, want 1 within the error estimate", res)
+			}
+			if res.Error > mc.Error/2 {
+				t.Errorf("Error = %v, want well below the Monte Carlo error %v", res.Error, mc.Error)
+			}
+		})
+	}
+
+	t.Run("IntegralQuasiMonteCarlo - sobol falls back to halton above 10 dimensions", func(t *testing.T) {
+		// ∫ Σ x_i over [0,1]^20 = 10
+		sum := func(x []float64) float64 {
+			s := 0.
+			for _, v := range x {
+				s += v
+			}
+			return s
+		}
+		lower, upper := make([]float64, 20), make([]float64, 20)
+		for i := range upper {
+			upper[i] = 1
+		}
+
+		sobol, err := numericalanalysis.IntegralQuasiMonteCarlo(sum, lower, upper, 8*1024, numericalanalysis.SobolSequence, rand.New(rand.NewSource(3)))
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		halton, _ := numericalanalysis.IntegralQuasiMonteCarlo(sum, lower, upper, 8*1024, numericalanalysis.HaltonSequence, rand.New(rand.NewSource(3)))
+		if sobol != halton {
+			t.Errorf("result = %+v, want the Halton result %+v", sobol, halton)
+		}
+		if math.Abs(sobol.Value-10) > 4*sobol.Error {
+			t.Errorf("result = %+v, want 10 within the error estimate", sobol)
+		}
+	})
+
+	t.Run("input validation", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		if _, err := numericalanalysis.IntegralMonteCarlo(f, lower, upper, 1, rng); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+		if _, err := numericalanalysis.IntegralMonteCarlo(f, lower, upper, 100, nil); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+		if _, err := numericalanalysis.IntegralQuasiMonteCarlo(f, lower, upper, 8, numericalanalysis.HaltonSequence, rng); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+}
+
+func TestLowDiscrepancySequences(t *testing.T) {
+	t.Run("HaltonPoints", func(t *testing.T) {
+		points, err := numericalanalysis.HaltonPoints(4, 2)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		expected := [][]float64{{0.5, 1. / 3}, {0.25, 2. / 3}, {0.75, 1. / 9}, {0.125, 4. / 9}}
+		for i := range expected {
+			assertSlice(t, "point", points[i], expected[i], 1e-15)
+		}
+	})
+
+	t.Run("SobolPoints", func(t *testing.T) {
+		points, err := numericalanalysis.SobolPoints(6, 3)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		expected := [][]float64{
+			{0, 0, 0},
+			{0.5, 0.5, 0.5},
+			{0.75, 0.25, 0.25},
+			{0.25, 0.75, 0.75},
+			{0.375, 0.375, 0.625},
+			{0.875, 0.875, 0.125},
+		}
+		for i := range expected {
+			assertSlice(t, "point", points[i], expected[i], 1e-15)
+		}
+	})
+
+	t.Run("SobolPoints - stratification", func(t *testing.T) {
+		// Every dimension of the first 2^k points hits each interval [j/2^k, (j+1)/2^k) once
+		n := 64
+		points, err := numericalanalysis.SobolPoints(n, 10)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		for i := range 10 {
+			seen := make([]bool, n)
+			for _, p := range points {
+				seen[int(p[i]*float64(n))] = true
+			}
+			for j := range seen {
+				if !seen[j] {
+					t.Fatalf("dimension %d misses interval %d", i, j)
+				}
+			}
+		}
+	})
+
+	t.Run("input validation", func(t *testing.T) {
+		if _, err := numericalanalysis.SobolPoints(10, 11); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+		if _, err := numericalanalysis.HaltonPoints(0, 2); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+}
@@ -0,0 +1,221 @@
+package numericalanalysis
+
+import (
+	"context"
+	"math"
+	"sort"
+)
+
+// neldermead.go
+// Derivative-free Nelder–Mead simplex minimizer
+
+// NelderMeadSimplex builds an initial simplex for NelderMeadExtremum
+// x0[n]: first vertex of the simplex
+// step[n]: offset along each axis for the remaining n vertices
+func NelderMeadSimplex(x0, step []float64) ([][]float64, error) {
+	n := len(x0)
+
+	// Check input
+	if n == 0 || len(step) != n {
+		return nil, ErrWrongInput
+	}
+	for i := range step {
+		if step[i] == 0 {
+			return nil, ErrWrongInput
+		}
+	}
+
+	simplex := make([][]float64, n+1)
+	for i := range simplex {
+		simplex[i] = make([]float64, n)
+		copy(simplex[i], x0)
+		if i > 0 {
+			simplex[i][i-1] += step[i-1]
+		}
+	}
+
+	return simplex, nil
+}
+
+// NelderMeadExtremum method for finding a minimum of a function of many variables without derivatives
+// f: function to minimize
+// simplex[n+1][n]: initial simplex (see NelderMeadSimplex)
+// adaptive: use dimension-dependent coefficients (Gao & Han), recommended for n > 2
+// tolX: tolerance for the simplex size (max distance from the best vertex)
+// tolF: tolerance for the spread of function values over the simplex
+// maxIter: maximum number of iterations for each run
+// restarts: number of restarts with the initial simplex shape moved to the best point found;
+// a restart that does not converge in maxIter iterations ends the restarts
+func NelderMeadExtremum(f func(x []float64) float64, simplex [][]float64, adaptive bool, tolX, tolF float64, maxIter, restarts int) ([]float64, error) {
+	return NelderMeadExtremumContext(context.Background(), 0, f, simplex, adaptive, tolX, tolF, maxIter, restarts)
+}
+
+// NelderMeadExtremumContext is NelderMeadExtremum that stops on ctx cancellation or after maxEvals evaluations of f (0 = unlimited)
+func NelderMeadExtremumContext(ctx context.Context, maxEvals int, f func(x []float64) float64, simplex [][]float64, adaptive bool, tolX, tolF float64, maxIter, restarts int) ([]float64, error) {
+	// Check input
+	if len(simplex) < 2 || tolX <= 0 || tolF <= 0 || maxIter <= 0 || restarts < 0 {
+		return nil, ErrWrongInput
+	}
+	n := len(simplex) - 1
+	for i := range simplex {
+		if len(simplex[i]) != n {
+			return nil, ErrWrongInput
+		}
+	}
+	budget, err := newEvalBudget(ctx, maxEvals)
+	if err != nil {
+		return nil, err
+	}
+	f = budget.funcVec(f)
+
+	// Offsets of the initial simplex relative to its first vertex, used for restarts
+	shape := make([][]float64, n+1)
+	for i := range simplex {
+		shape[i] = make([]float64, n)
+		for j := range n {
+			shape[i][j] = simplex[i][j] - simplex[0][j]
+		}
+	}
+
+	best := simplex[0]
+	fBest := math.Inf(1)
+	for r := 0; r <= restarts; r++ {
+		start := simplex
+		if r > 0 {
+			start = make([][]float64, n+1)
+			for i := range start {
+				start[i] = make([]float64, n)
+				for j := range n {
+					start[i][j] = best[j] + shape[i][j]
+				}
+			}
+		}
+
+		x, fx, err := nelderMead(f, start, adaptive, tolX, tolF, maxIter, budget)
+		if err == ErrDidNotConverge && r > 0 { // Keep the point of the previous runs
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// Stop restarting once a restart gives no improvement
+		improved := fBest - fx
+		if fx < fBest {
+			best, fBest = x, fx
+		}
+		if r > 0 && improved <= tolF {
+			break
+		}
+	}
+
+	return best, nil
+}
+
+// nelderMead runs a single Nelder–Mead minimization from the given simplex
+func nelderMead(f func(x []float64) float64, start [][]float64, adaptive bool, tolX, tolF float64, maxIter int, budget *evalBudget) ([]float64, float64, error) {
+	n := len(start) - 1
+
+	// Reflection, expansion, contraction and shrink coefficients
+	alpha, gamma, rho, sigma := 1., 2., 0.5, 0.5
+	if adaptive {
+		nf := float64(n)
+		gamma = 1 + 2/nf
+		rho = 0.75 - 1/(2*nf)
+		sigma = 1 - 1/nf
+	}
+	if n == 1 {
+		sigma = 0.5
+	}
+
+	type vertex struct {
+		x []float64
+		f float64
+	}
+
+	// Initialize simplex
+	v := make([]vertex, n+1)
+	for i := range start {
+		x := make([]float64, n)
+		copy(x, start[i])
+		v[i] = vertex{x: x, f: f(x)}
+	}
+
+	// point returns c + t(x - c)
+	point := func(c, x []float64, t float64) vertex {
+		p := make([]float64, n)
+		for j := range p {
+			p[j] = c[j] + t*(x[j]-c[j])
+		}
+		return vertex{x: p, f: f(p)}
+	}
+
+	for range maxIter {
+		// Check cancellation and budget
+		if err := budget.check(); err != nil {
+			return nil, 0, err
+		}
+
+		// Order vertices by function value
+		sort.SliceStable(v, func(i, j int) bool {
+			return v[i].f < v[j].f
+		})
+
+		// Check termination on both the simplex size and the function spread
+		size := 0.
+		for i := 1; i <= n; i++ {
+			for j := range n {
+				size = math.Max(size, math.Abs(v[i].x[j]-v[0].x[j]))
+			}
+		}
+		if size <= tolX && v[n].f-v[0].f <= tolF {
+			return v[0].x, v[0].f, nil
+		}
+
+		// Centroid of all vertices except the worst
+		c := make([]float64, n)
+		for i := range n {
+			for j := range n {
+				c[j] += v[i].x[j] / float64(n)
+			}
+		}
+
+		// Reflection
+		r := point(c, v[n].x, -alpha)
+		switch {
+		case r.f < v[0].f:
+			// Expansion
+			e := point(c, r.x, gamma)
+			if e.f < r.f {
+				v[n] = e
+			} else {
+				v[n] = r
+			}
+			continue
+		case r.f < v[n-1].f:
+			v[n] = r
+			continue
+		case r.f < v[n].f:
+			// Outside contraction
+			oc := point(c, r.x, rho)
+			if oc.f <= r.f {
+				v[n] = oc
+				continue
+			}
+		default:
+			// Inside contraction
+			ic := point(c, v[n].x, rho)
+			if ic.f < v[n].f {
+				v[n] = ic
+				continue
+			}
+		}
+
+		// Shrink towards the best vertex
+		for i := 1; i <= n; i++ {
+			v[i] = point(v[0].x, v[i].x, sigma)
+		}
+	}
+
+	return nil, 0, ErrDidNotConverge
+}
@@ -0,0 +1,164 @@
+package numericalanalysis_test
+
+import (
+	"math"
+	"testing"
+
+	numericalanalysis "github.com/Russia9/numerical-analysis"
+)
+
+func TestNelderMeadSimplex(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		simplex, err := numericalanalysis.NelderMeadSimplex([]float64{1, 2}, []float64{0.5, -1})
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+
+		expected := [][]float64{{1, 2}, {1.5, 2}, {1, 1}}
+		for i := range expected {
+			for j := range expected[i] {
+				if simplex[i][j] != expected[i][j] {
+					t.Errorf("simplex[%d][%d] = %v, want %v", i, j, simplex[i][j], expected[i][j])
+				}
+			}
+		}
+	})
+
+	t.Run("input validation - zero step", func(t *testing.T) {
+		_, err := numericalanalysis.NelderMeadSimplex([]float64{1, 2}, []float64{0.5, 0})
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+
+	t.Run("input validation - mismatched lengths", func(t *testing.T) {
+		_, err := numericalanalysis.NelderMeadSimplex([]float64{1, 2}, []float64{0.5})
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+}
+
+func TestNelderMeadExtremum(t *testing.T) {
+	t.Run("input validation - degenerate simplex", func(t *testing.T) {
+		_, err := numericalanalysis.NelderMeadExtremum(rosenbrock, [][]float64{{0, 0}}, false, 1e-6, 1e-6, 100, 0)
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+
+	t.Run("input validation - ragged simplex", func(t *testing.T) {
+		_, err := numericalanalysis.NelderMeadExtremum(rosenbrock, [][]float64{{0, 0}, {1}, {0, 1}}, false, 1e-6, 1e-6, 100, 0)
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+
+	t.Run("input validation - non-positive tolerance", func(t *testing.T) {
+		simplex, _ := numericalanalysis.NelderMeadSimplex([]float64{0, 0}, []float64{1, 1})
+		_, err := numericalanalysis.NelderMeadExtremum(rosenbrock, simplex, false, 0, 1e-6, 100, 0)
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+
+	t.Run("single variable", func(t *testing.T) {
+		// f(x) = (x-3)^2, minimum at x = 3
+		f := func(x []float64) float64 {
+			return (x[0] - 3) * (x[0] - 3)
+		}
+		simplex, _ := numericalanalysis.NelderMeadSimplex([]float64{0}, []float64{1})
+
+		result, err := numericalanalysis.NelderMeadExtremum(f, simplex, true, 1e-8, 1e-12, 1000, 0)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(result[0]-3) > 1e-6 {
+			t.Errorf("result[0] = %v, want ~3", result[0])
+		}
+	})
+
+	t.Run("rosenbrock function", func(t *testing.T) {
+		simplex, _ := numericalanalysis.NelderMeadSimplex([]float64{-1.2, 1}, []float64{0.5, 0.5})
+
+		result, err := numericalanalysis.NelderMeadExtremum(rosenbrock, simplex, false, 1e-8, 1e-12, 5000, 2)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(result[0]-1) > 1e-4 || math.Abs(result[1]-1) > 1e-4 {
+			t.Errorf("result = %v, want ~[1 1]", result)
+		}
+	})
+
+	t.Run("noisy objective", func(t *testing.T) {
+		// f(x,y) = (x-1)^2 + (y+1)^2 + small deterministic noise
+		f := func(x []float64) float64 {
+			noise := 1e-6 * math.Sin(1e7*x[0]) * math.Cos(1e7*x[1])
+			return (x[0]-1)*(x[0]-1) + (x[1]+1)*(x[1]+1) + noise
+		}
+		simplex, _ := numericalanalysis.NelderMeadSimplex([]float64{5, 5}, []float64{1, 1})
+
+		result, err := numericalanalysis.NelderMeadExtremum(f, simplex, false, 1e-4, 1e-5, 5000, 1)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(result[0]-1) > 1e-2 || math.Abs(result[1]+1) > 1e-2 {
+			t.Errorf("result = %v, want ~[1 -1]", result)
+		}
+	})
+
+	t.Run("high dimensions with adaptive coefficients", func(t *testing.T) {
+		// f(x) = Σ (x_i - i)^2, minimum at (0, 1, ..., n-1)
+		n := 10
+		f := func(x []float64) float64 {
+			sum := 0.
+			for i := range x {
+				sum += (x[i] - float64(i)) * (x[i] - float64(i))
+			}
+			return sum
+		}
+		step := make([]float64, n)
+		for i := range step {
+			step[i] = 1
+		}
+		simplex, _ := numericalanalysis.NelderMeadSimplex(make([]float64, n), step)
+
+		result, err := numericalanalysis.NelderMeadExtremum(f, simplex, true, 1e-6, 1e-10, 50000, 3)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		for i := range result {
+			if math.Abs(result[i]-float64(i)) > 1e-3 {
+				t.Errorf("result[%d] = %v, want ~%d", i, result[i], i)
+			}
+		}
+	})
+
+	t.Run("restart that does not converge", func(t *testing.T) {
+		// f(x) = x^2 for x < 4 and unbounded below beyond, the restart simplex reaches x = 4 and diverges
+		f := func(x []float64) float64 {
+			if x[0] >= 4 {
+				return -x[0]
+			}
+			return x[0] * x[0]
+		}
+		simplex, _ := numericalanalysis.NelderMeadSimplex([]float64{-1}, []float64{4})
+
+		result, err := numericalanalysis.NelderMeadExtremum(f, simplex, false, 1e-8, 1e-12, 100, 2)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(result[0]) > 1e-6 {
+			t.Errorf("result[0] = %v, want ~0", result[0])
+		}
+	})
+
+	t.Run("iteration limit", func(t *testing.T) {
+		simplex, _ := numericalanalysis.NelderMeadSimplex([]float64{-1.2, 1}, []float64{0.5, 0.5})
+
+		_, err := numericalanalysis.NelderMeadExtremum(rosenbrock, simplex, false, 1e-12, 1e-12, 5, 0)
+		if err != numericalanalysis.ErrDidNotConverge {
+			t.Errorf("err = %v, want ErrDidNotConverge", err)
+		}
+	})
+}
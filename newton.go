@@ -1,9 +1,65 @@
 package numericalanalysis
 
 import (
+	"context"
 	"math"
 )
 
+// NewtonIteration describes a single iteration of a Newton solver
+type NewtonIteration struct {
+	Iteration int       // Iteration index, 0 is the initial guess
+	X         []float64 // Current point
+	F         float64   // f(x) for DampedNewtonExtremum, residual norm for SENewton
+	GradNorm  float64   // Gradient norm (of ½|r|² for SENewton)
+	Alpha     float64   // Damping factor used for the next step (1 for SENewton)
+	Step      float64   // Length of the step that led to X
+}
+
+// NewtonCallback is called on every iteration; returning true aborts the solver with ErrAborted
+type NewtonCallback func(it NewtonIteration) (abort bool)
+
+// NewtonOptions are optional settings for the Newton solvers
+type NewtonOptions struct {
+	Callback NewtonCallback  // Per-iteration callback
+	Trace    bool            // Record every iteration in NewtonResult.Trace
+	Context  context.Context // Stop with ErrCanceled when the context is done, nil means context.Background()
+	MaxEvals int             // Maximum number of function evaluations, 0 means unlimited
+	Workers  int             // Goroutines evaluating the finite difference stencils of DampedNewtonExtremumWithOptions, 0 means 1; f must be safe for concurrent use if > 1
+
+	// Exact derivatives replacing the finite differences, e.g. DualGradient, DualHessian and DualJacobian
+	Gradient func(x []float64) []float64 // Gradient of f for DampedNewtonExtremumWithOptions
+	Hessian  func(x []float64) Matrix    // Hessian matrix of f for DampedNewtonExtremumWithOptions
+	Jacobian func(u []float64) Matrix    // Jacobian matrix of the system for SENewtonWithOptions
+}
+
+// budget creates the evaluation budget described by the options
+func (opts NewtonOptions) budget() (*evalBudget, error) {
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return newEvalBudget(ctx, opts.MaxEvals)
+}
+
+// NewtonResult is the outcome of a Newton solver
+type NewtonResult struct {
+	X     []float64         // Solution, or the last iterate if the solver failed
+	Trace []NewtonIteration // Recorded iterations if NewtonOptions.Trace is set
+}
+
+// report passes the iteration to the callback and records it in the trace
+func (r *NewtonResult) report(opts NewtonOptions, it NewtonIteration) error {
+	it.X = append([]float64(nil), it.X...)
+	r.X = it.X
+	if opts.Trace {
+		r.Trace = append(r.Trace, it)
+	}
+	if opts.Callback != nil && opts.Callback(it) {
+		return ErrAborted
+	}
+	return nil
+}
+
 // DampedNewtonExtremum method for finding an extremum of a function of many variables
 // f: function to minimize
 // x0: initial guess for the solution
@@ -13,43 +69,74 @@ import (
 // eps: tolerance
 // maxBacktrack: maximum number of backtracking steps
 func DampedNewtonExtremum(f func(x []float64) float64, x0 []float64, deltaX []float64, alpha0, C1 float64, eps float64, maxBacktrack int) ([]float64, error) {
+	res, err := DampedNewtonExtremumWithOptions(f, x0, deltaX, alpha0, C1, eps, maxBacktrack, NewtonOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return res.X, nil
+}
+
+// DampedNewtonExtremumContext is DampedNewtonExtremum that stops on ctx cancellation or after maxEvals evaluations of f (0 = unlimited)
+func DampedNewtonExtremumContext(ctx context.Context, maxEvals int, f func(x []float64) float64, x0 []float64, deltaX []float64, alpha0, C1 float64, eps float64, maxBacktrack int) ([]float64, error) {
+	res, err := DampedNewtonExtremumWithOptions(f, x0, deltaX, alpha0, C1, eps, maxBacktrack, NewtonOptions{Context: ctx, MaxEvals: maxEvals})
+	if err != nil {
+		return nil, err
+	}
+	return res.X, nil
+}
+
+// DampedNewtonExtremumWithOptions is DampedNewtonExtremum with a per-iteration callback, an iteration trace,
+// cancellation, an evaluation budget, exact derivatives and concurrent finite differences. deltaX may be nil if both the gradient and the Hessian are set.
+// On ErrDidNotConverge, ErrAborted, ErrCanceled and ErrBudgetExceeded the result holds the last iterate and the trace recorded so far.
+func DampedNewtonExtremumWithOptions(f func(x []float64) float64, x0 []float64, deltaX []float64, alpha0, C1 float64, eps float64, maxBacktrack int, opts NewtonOptions) (NewtonResult, error) {
 	n := len(x0)
 
 	// Check input
-	if n == 0 || len(deltaX) != n || C1 <= 0 || C1 >= 1 || eps <= 0 || alpha0 <= 0 {
-		return nil, ErrWrongInput
+	if n == 0 || C1 <= 0 || C1 >= 1 || eps <= 0 || alpha0 <= 0 || opts.Workers < 0 {
+		return NewtonResult{}, ErrWrongInput
 	}
-	for i := range deltaX {
-		if deltaX[i] <= 0 {
-			return nil, ErrWrongInput
+	if opts.Gradient == nil || opts.Hessian == nil {
+		if len(deltaX) != n {
+			return NewtonResult{}, ErrWrongInput
 		}
-	}
-
-	// helper to evaluate at x + s
-	at := func(base []float64, shift map[int]float64) []float64 {
-		y := make([]float64, n)
-		copy(y, base)
-		for i, v := range shift {
-			y[i] += v
+		for i := range deltaX {
+			if deltaX[i] <= 0 {
+				return NewtonResult{}, ErrWrongInput
+			}
 		}
-		return y
 	}
+	budget, err := opts.budget()
+	if err != nil {
+		return NewtonResult{}, err
+	}
+	f = budget.funcVec(f)
+	workers := max(opts.Workers, 1)
 
 	C2 := 1 / C1
 	x := x0
 	alpha := alpha0
+	step := 0.
+	var res NewtonResult
 
 	fx := f(x)
-	for {
+	for k := 0; ; k++ {
 		// Calculate gradient
-		grad := make([]float64, len(x))
-		for i := range x {
-			h := deltaX[i]
+		var grad []float64
+		if opts.Gradient != nil {
+			grad = opts.Gradient(x)
+		} else if grad, err = GradientParallel(f, x, deltaX, CentralDifference, workers); err != nil {
+			return res, err
+		}
 
-			fp := f(at(x, map[int]float64{i: +h}))
-			fn := f(at(x, map[int]float64{i: -h}))
+		// Check cancellation and budget
+		if err := budget.check(); err != nil {
+			return res, err
+		}
 
-			grad[i] = (fp - fn) / (2 * h)
+		// Report iteration
+		err := res.report(opts, NewtonIteration{Iteration: k, X: x, F: fx, GradNorm: Norm(grad), Alpha: alpha, Step: step})
+		if err != nil {
+			return res, err
 		}
 
 		// Check grad G
@@ -58,29 +145,11 @@ func DampedNewtonExtremum(f func(x []float64) float64, x0 []float64, deltaX []fl
 		}
 
 		// Calculate Hessian matrix
-		H := make(Matrix, n)
-		for i := range n {
-			H[i] = make([]float64, n)
-		}
-		for i := range n {
-			hi := deltaX[i]
-
-			// diagonal
-			fip := f(at(x, map[int]float64{i: +hi}))
-			fim := f(at(x, map[int]float64{i: -hi}))
-			H[i][i] = (fip - 2*fx + fim) / (hi * hi)
-
-			// off-diagonals
-			for j := i + 1; j < n; j++ {
-				hj := deltaX[j]
-				fpp := f(at(x, map[int]float64{i: +hi, j: +hj}))
-				fpm := f(at(x, map[int]float64{i: +hi, j: -hj}))
-				fmp := f(at(x, map[int]float64{i: -hi, j: +hj}))
-				fmm := f(at(x, map[int]float64{i: -hi, j: -hj}))
-				val := (fpp - fpm - fmp + fmm) / (4 * hi * hj)
-				H[i][j] = val
-				H[j][i] = val
-			}
+		var H Matrix
+		if opts.Hessian != nil {
+			H = opts.Hessian(x)
+		} else if H, err = HessianParallel(f, x, deltaX, workers); err != nil {
+			return res, err
 		}
 
 		accepted := false
@@ -90,7 +159,7 @@ func DampedNewtonExtremum(f func(x []float64) float64, x0 []float64, deltaX []fl
 			// X(i+1) = X - (H + αI)^(-1) * ∇f(x)
 			H1, err := H.Add(IdentityMatrix(len(x)).MulNumber(alphaTry))
 			if err != nil {
-				return nil, err
+				return res, err
 			}
 			H2, err := H1.Inverse()
 			if err != nil {
@@ -99,12 +168,12 @@ func DampedNewtonExtremum(f func(x []float64) float64, x0 []float64, deltaX []fl
 			}
 			H3, err := H2.Mul(Column(grad))
 			if err != nil {
-				return nil, err
+				return res, err
 			}
 			H3 = H3.MulNumber(-1)
 			H4, err := Column(x).Add(H3)
 			if err != nil {
-				return nil, err
+				return res, err
 			}
 
 			// Convert back to float slice
@@ -116,6 +185,7 @@ func DampedNewtonExtremum(f func(x []float64) float64, x0 []float64, deltaX []fl
 			fx1 := f(x1)
 			if fx1 < fx {
 				fx = fx1
+				step = Norm(H3.Transpose()[0])
 				x = x1
 				alpha = C1 * alphaTry
 				accepted = true
@@ -125,43 +195,66 @@ func DampedNewtonExtremum(f func(x []float64) float64, x0 []float64, deltaX []fl
 			}
 		}
 
+		if err := budget.check(); err != nil {
+			return res, err
+		}
 		if !accepted {
-			return nil, ErrDidNotConverge
+			return res, ErrDidNotConverge
 		}
 	}
 
-	return x, nil
+	return res, nil
 }
 
 // SENewton method for solving a system of nonlinear equations
 // m: number of equations
 // f[m]: system of nonlinear equations
 // u0[m]: initial guess for the solution
-// deltaU[m]: step size for each variable (for differential calculations)
+// deltaU[m]: step size for each variable (for differential calculations, nil selects the steps automatically)
 // eps: tolerance for convergence
 func SENewton(f []func(u []float64) float64, u0 []float64, deltaU []float64, eps float64) ([]float64, error) {
+	res, err := SENewtonWithOptions(f, u0, deltaU, eps, NewtonOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return res.X, nil
+}
+
+// SENewtonContext is SENewton that stops on ctx cancellation or after maxEvals evaluations of the equations (0 = unlimited)
+func SENewtonContext(ctx context.Context, maxEvals int, f []func(u []float64) float64, u0 []float64, deltaU []float64, eps float64) ([]float64, error) {
+	res, err := SENewtonWithOptions(f, u0, deltaU, eps, NewtonOptions{Context: ctx, MaxEvals: maxEvals})
+	if err != nil {
+		return nil, err
+	}
+	return res.X, nil
+}
+
+// SENewtonWithOptions is SENewton with a per-iteration callback, an iteration trace, cancellation, an evaluation budget
+// and an exact Jacobian. deltaU may be nil to select the steps automatically.
+// On ErrAborted, ErrCanceled and ErrBudgetExceeded the result holds the last iterate and the trace recorded so far.
+func SENewtonWithOptions(f []func(u []float64) float64, u0 []float64, deltaU []float64, eps float64, opts NewtonOptions) (NewtonResult, error) {
 	// Check input
 	if len(f) == 0 || len(u0) == 0 || len(f) != len(u0) || eps <= 0 {
-		return nil, ErrWrongInput
+		return NewtonResult{}, ErrWrongInput
 	}
+	budget, err := opts.budget()
+	if err != nil {
+		return NewtonResult{}, err
+	}
+	f = budget.funcsVec(f)
 
 	u := make([]float64, len(u0))
 	copy(u, u0)
+	step := 0.
+	var res NewtonResult
 
-	for {
+	for k := 0; ; k++ {
 		// Calculate Jacobian matrix
-		J := make(Matrix, len(f))
-		for i := range f {
-			J[i] = make([]float64, len(f))
-
-			for j := range f {
-				u_cur := make([]float64, len(u))
-				copy(u_cur, u)
-
-				u_cur[j] += deltaU[j]
-
-				J[i][j] = (f[i](u_cur) - f[i](u)) / deltaU[j]
-			}
+		var J Matrix
+		if opts.Jacobian != nil {
+			J = opts.Jacobian(u)
+		} else if J, err = Jacobian(f, u, deltaU, CentralDifference); err != nil {
+			return res, err
 		}
 
 		// Calculate residual vector
@@ -176,6 +269,24 @@ func SENewton(f []func(u []float64) float64, u0 []float64, deltaU []float64, eps
 			norm += r[i] * r[i]
 		}
 		norm = math.Sqrt(norm)
+
+		// Check cancellation and budget
+		if err := budget.check(); err != nil {
+			return res, err
+		}
+
+		// Report iteration, the gradient of ½|r|² is Jᵀr
+		grad := make([]float64, len(u))
+		for i := range r {
+			for j := range u {
+				grad[j] += J[i][j] * r[i]
+			}
+		}
+		err := res.report(opts, NewtonIteration{Iteration: k, X: u, F: norm, GradNorm: Norm(grad), Alpha: 1, Step: step})
+		if err != nil {
+			return res, err
+		}
+
 		if norm < eps {
 			break
 		}
@@ -183,14 +294,15 @@ func SENewton(f []func(u []float64) float64, u0 []float64, deltaU []float64, eps
 		// Solve linear system J * du = -r
 		du, err := Cramer(J, r)
 		if err != nil {
-			return nil, err
+			return res, err
 		}
 
 		// Update solution
 		for i := range u {
 			u[i] -= du[i]
 		}
+		step = Norm(du)
 	}
 
-	return u, nil
+	return res, nil
 }
@@ -1137,3 +1137,131 @@ func TestDampedNewtonExtremum(t *testing.T) {
 		}
 	})
 }
+
+func TestDampedNewtonExtremumWithOptions(t *testing.T) {
+	f := func(x []float64) float64 {
+		return (x[0]-1)*(x[0]-1) + (x[1]-2)*(x[1]-2)
+	}
+	x0 := []float64{5.0, 5.0}
+	deltaX := []float64{1e-4, 1e-4}
+
+	t.Run("trace", func(t *testing.T) {
+		result, err := numericalanalysis.DampedNewtonExtremumWithOptions(f, x0, deltaX, 100, 0.5, 1e-6, 10, numericalanalysis.NewtonOptions{Trace: true})
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if len(result.Trace) < 2 {
+			t.Fatalf("len(Trace) = %v, want at least 2", len(result.Trace))
+		}
+
+		first, last := result.Trace[0], result.Trace[len(result.Trace)-1]
+		if first.Iteration != 0 || first.Step != 0 || first.Alpha != 100 || first.F != 25 {
+			t.Errorf("Trace[0] = %+v, want initial iteration", first)
+		}
+		if last.GradNorm >= 1e-6 {
+			t.Errorf("last GradNorm = %v, want < 1e-6", last.GradNorm)
+		}
+		for i := 1; i < len(result.Trace); i++ {
+			if result.Trace[i].Iteration != i || result.Trace[i].F >= result.Trace[i-1].F || result.Trace[i].Step <= 0 {
+				t.Errorf("Trace[%d] = %+v, want decreasing F and positive step", i, result.Trace[i])
+			}
+		}
+		if math.Abs(result.X[0]-1) > 1e-3 || math.Abs(result.X[1]-2) > 1e-3 {
+			t.Errorf("X = %v, want ~[1 2]", result.X)
+		}
+	})
+
+	t.Run("abort from callback", func(t *testing.T) {
+		calls := 0
+		callback := func(it numericalanalysis.NewtonIteration) bool {
+			calls++
+			return it.Iteration == 1
+		}
+
+		result, err := numericalanalysis.DampedNewtonExtremumWithOptions(f, x0, deltaX, 100, 0.5, 1e-6, 10, numericalanalysis.NewtonOptions{Callback: callback})
+		if err != numericalanalysis.ErrAborted {
+			t.Errorf("err = %v, want ErrAborted", err)
+		}
+		if calls != 2 {
+			t.Errorf("calls = %v, want 2", calls)
+		}
+		if result.X == nil || result.Trace != nil {
+			t.Errorf("result = %+v, want last iterate without trace", result)
+		}
+	})
+
+	t.Run("trace on failure", func(t *testing.T) {
+		result, err := numericalanalysis.DampedNewtonExtremumWithOptions(f, x0, deltaX, 100, 0.5, 1e-6, 0, numericalanalysis.NewtonOptions{Trace: true})
+		if err != numericalanalysis.ErrDidNotConverge {
+			t.Fatalf("err = %v, want ErrDidNotConverge", err)
+		}
+		if len(result.Trace) == 0 {
+			t.Errorf("len(Trace) = 0, want recorded iterations")
+		}
+	})
+
+	t.Run("parallel finite differences", func(t *testing.T) {
+		sequential, err := numericalanalysis.DampedNewtonExtremumWithOptions(rosenbrock, []float64{-1.2, 1}, []float64{1e-4, 1e-4}, 1, 0.5, 1e-6, 64, numericalanalysis.NewtonOptions{Trace: true})
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		parallel, err := numericalanalysis.DampedNewtonExtremumWithOptions(rosenbrock, []float64{-1.2, 1}, []float64{1e-4, 1e-4}, 1, 0.5, 1e-6, 64, numericalanalysis.NewtonOptions{Trace: true, Workers: 4, MaxEvals: 100000})
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if len(parallel.Trace) != len(sequential.Trace) {
+			t.Fatalf("len(Trace) = %v, want %v", len(parallel.Trace), len(sequential.Trace))
+		}
+		assertSlice(t, "X", parallel.X, sequential.X, 0)
+	})
+
+	t.Run("input validation - negative workers", func(t *testing.T) {
+		_, err := numericalanalysis.DampedNewtonExtremumWithOptions(f, x0, deltaX, 100, 0.5, 1e-6, 10, numericalanalysis.NewtonOptions{Workers: -1})
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+}
+
+func TestSENewtonWithOptions(t *testing.T) {
+	// x^2 + y^2 = 4, x - y = 0, solution (√2, √2)
+	f := []func(u []float64) float64{
+		func(u []float64) float64 { return u[0]*u[0] + u[1]*u[1] - 4 },
+		func(u []float64) float64 { return u[0] - u[1] },
+	}
+	u0 := []float64{1.0, 2.0}
+	deltaU := []float64{1e-6, 1e-6}
+
+	t.Run("trace", func(t *testing.T) {
+		result, err := numericalanalysis.SENewtonWithOptions(f, u0, deltaU, 1e-8, numericalanalysis.NewtonOptions{Trace: true})
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if len(result.Trace) < 2 {
+			t.Fatalf("len(Trace) = %v, want at least 2", len(result.Trace))
+		}
+		if first := result.Trace[0]; first.F != math.Sqrt2 || first.Step != 0 || first.Alpha != 1 {
+			t.Errorf("Trace[0] = %+v, want initial residual √2", first)
+		}
+		if last := result.Trace[len(result.Trace)-1]; last.F >= 1e-8 {
+			t.Errorf("last F = %v, want < 1e-8", last.F)
+		}
+		if math.Abs(result.X[0]-math.Sqrt2) > 1e-6 || math.Abs(result.X[1]-math.Sqrt2) > 1e-6 {
+			t.Errorf("X = %v, want ~[√2 √2]", result.X)
+		}
+	})
+
+	t.Run("abort from callback", func(t *testing.T) {
+		callback := func(it numericalanalysis.NewtonIteration) bool {
+			return true
+		}
+
+		result, err := numericalanalysis.SENewtonWithOptions(f, u0, deltaU, 1e-8, numericalanalysis.NewtonOptions{Callback: callback})
+		if err != numericalanalysis.ErrAborted {
+			t.Errorf("err = %v, want ErrAborted", err)
+		}
+		if result.X[0] != 1 || result.X[1] != 2 {
+			t.Errorf("X = %v, want initial guess", result.X)
+		}
+	})
+}
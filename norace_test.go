@@ -0,0 +1,9 @@
+//go:build !race
+
+package numericalanalysis_test
+
+// norace_test.go
+// Race detector flag for tests that depend on allocation counts
+
+// raceEnabled reports that the race detector is on, sync.Pool then drops items at random
+const raceEnabled = false
@@ -0,0 +1,292 @@
+package numericalanalysis
+
+import "math"
+
+// oscillatory.go
+// Oscillatory and weighted integrals: Clenshaw–Curtis, Filon, Levin and Gaussian rules for arbitrary weights
+
+// ClenshawCurtisRule returns the Clenshaw–Curtis rule on [-1, 1] with the n+1 Chebyshev extreme points cos(kπ/n)
+// as nodes, exact for polynomials of degree n. The nodes are in ascending order and include the endpoints.
+func ClenshawCurtisRule(n int) (GaussRule, error) {
+	// Check input
+	if n < 1 {
+		return GaussRule{}, ErrWrongInput
+	}
+
+	rule := GaussRule{Nodes: make([]float64, n+1), Weights: make([]float64, n+1)}
+	for k := 0; k <= n; k++ {
+		theta := float64(k) * math.Pi / float64(n)
+
+		// w_k = c_k/n (1 - Σ b_j cos(2jθ)/(4j² - 1)), c_k = 1 at the endpoints, b_j = 1 for j = n/2
+		sum := 0.
+		for j := 1; j <= n/2; j++ {
+			b := 2.
+			if 2*j == n {
+				b = 1
+			}
+			sum += b * math.Cos(2*float64(j)*theta) / float64(4*j*j-1)
+		}
+		w := (1 - sum) / float64(n)
+		if k > 0 && k < n {
+			w *= 2
+		}
+
+		rule.Nodes[k] = -math.Cos(theta)
+		rule.Weights[k] = w
+	}
+	if n%2 == 0 {
+		rule.Nodes[n/2] = 0
+	}
+
+	return rule, nil
+}
+
+// IntegralClenshawCurtis calculates the integral of f over [a, b] with the (n+1)-point Clenshaw–Curtis rule
+func IntegralClenshawCurtis(f Func1D, a, b float64, n int) (float64, error) {
+	rule, err := ClenshawCurtisRule(n)
+	if err != nil {
+		return 0, err
+	}
+	c, h := (a+b)/2, (b-a)/2
+	return h * rule.Integrate(func(t float64) float64 { return f(c + h*t) }), nil
+}
+
+// IntegralFilonCos calculates ∫[a,b] f(x)·cos(ωx) dx with Filon's rule, which interpolates only f by
+// piecewise quadratics and integrates the oscillating factor exactly, so N does not have to resolve the oscillations.
+// N: number of subintervals, must be even
+func IntegralFilonCos(f Func1D, a, b, omega float64, N int) (float64, error) {
+	c, _, err := filon(f, a, b, omega, N)
+	return c, err
+}
+
+// IntegralFilonSin calculates ∫[a,b] f(x)·sin(ωx) dx with Filon's rule
+// N: number of subintervals, must be even
+func IntegralFilonSin(f Func1D, a, b, omega float64, N int) (float64, error) {
+	_, s, err := filon(f, a, b, omega, N)
+	return s, err
+}
+
+// filon calculates both Filon integrals ∫ f cos(ωx) and ∫ f sin(ωx)
+func filon(f Func1D, a, b, omega float64, N int) (float64, float64, error) {
+	// Check input
+	if N < 2 || N%2 != 0 {
+		return 0, 0, ErrWrongInput
+	}
+
+	h := (b - a) / float64(N)
+	theta := omega * h
+
+	// Filon coefficients, with series expansions for small θ to avoid cancellation
+	var alpha, beta, gamma float64
+	if t := math.Abs(theta); t < 1./6 {
+		t2 := theta * theta
+		alpha = theta * t2 * (2./45 - t2*(2./315-t2*2./4725))
+		beta = 2./3 + t2*(2./15-t2*(4./105-t2*2./567))
+		gamma = 4./3 - t2*(2./15-t2*(1./210-t2/11340))
+	} else {
+		s, c := math.Sincos(theta)
+		t3 := theta * theta * theta
+		alpha = (theta*theta + theta*s*c - 2*s*s) / t3
+		beta = 2 * (theta*(1+c*c) - 2*s*c) / t3
+		gamma = 4 * (s - theta*c) / t3
+	}
+
+	// Sums over the even and odd points
+	var cEven, cOdd, sEven, sOdd float64
+	var fa, fb float64
+	for i := 0; i <= N; i++ {
+		x := a + float64(i)*h
+		fx := f(x)
+		s, c := math.Sincos(omega * x)
+		w := 1.
+		switch i {
+		case 0:
+			fa, w = fx, 0.5
+		case N:
+			fb, w = fx, 0.5
+		}
+		if i%2 == 0 {
+			cEven += w * fx * c
+			sEven += w * fx * s
+		} else {
+			cOdd += fx * c
+			sOdd += fx * s
+		}
+	}
+
+	sa, ca := math.Sincos(omega * a)
+	sb, cb := math.Sincos(omega * b)
+	cosPart := h * (alpha*(fb*sb-fa*sa) + beta*cEven + gamma*cOdd)
+	sinPart := h * (alpha*(fa*ca-fb*cb) + beta*sEven + gamma*sOdd)
+	return cosPart, sinPart, nil
+}
+
+// IntegralLevin calculates ∫[a,b] f(x)·cos(ωg(x)) dx and ∫[a,b] f(x)·sin(ωg(x)) dx with Levin's collocation method:
+// a non-oscillatory p with p' + iωg'p = f is found at n Chebyshev points, then the integral is [p·e^(iωg)] from a to b.
+// The accuracy improves as ω grows; g' must not vanish on [a, b].
+// dg: derivative of g
+// n: number of collocation points, n >= 2
+func IntegralLevin(f, g, dg Func1D, a, b, omega float64, n int) (float64, float64, error) {
+	// Check input
+	if n < 2 || a == b {
+		return 0, 0, ErrWrongInput
+	}
+
+	// Chebyshev basis T_k(t), t = (2x - a - b)/(b - a), and its x-derivatives at t
+	scale := 2 / (b - a)
+	basis := func(t float64) ([]float64, []float64) {
+		T := make([]float64, n)
+		dT := make([]float64, n)
+		T[0] = 1
+		if n > 1 {
+			T[1], dT[1] = t, scale
+		}
+		for k := 2; k < n; k++ {
+			T[k] = 2*t*T[k-1] - T[k-2]
+			dT[k] = 2*scale*T[k-1] + 2*t*dT[k-1] - dT[k-2]
+		}
+		return T, dT
+	}
+
+	// p = u + iv with u = Σ c_k T_k, v = Σ d_k T_k; unknowns (c, d):
+	// u' - ωg'v = f, v' + ωg'u = 0 at every collocation point
+	A := make(Matrix, 2*n)
+	free := make([]float64, 2*n)
+	for j := range n {
+		t := -math.Cos(float64(j) * math.Pi / float64(n-1))
+		x := (a+b)/2 + (b-a)/2*t
+		T, dT := basis(t)
+		wg := omega * dg(x)
+
+		A[j] = make([]float64, 2*n)
+		A[n+j] = make([]float64, 2*n)
+		for k := range n {
+			A[j][k], A[j][n+k] = dT[k], -wg*T[k]
+			A[n+j][k], A[n+j][n+k] = wg*T[k], dT[k]
+		}
+		free[j] = f(x)
+	}
+
+	coef, err := GaussianElimination(A, free)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// [p e^(iωg)] at t = ±1
+	at := func(t, x float64) (float64, float64) {
+		T, _ := basis(t)
+		u, v := 0., 0.
+		for k := range n {
+			u += coef[k] * T[k]
+			v += coef[n+k] * T[k]
+		}
+		s, c := math.Sincos(omega * g(x))
+		return u*c - v*s, u*s + v*c
+	}
+	cb, sb := at(1, b)
+	ca, sa := at(-1, a)
+
+	return cb - ca, sb - sa, nil
+}
+
+// GaussRuleForWeight returns the n-point Gaussian rule for the weight w(x) >= 0 on the finite interval [a, b],
+// i.e. ∫[a,b] w(x) g(x) dx ≈ Σ Weights[i]·g(Nodes[i]), exact for polynomials g of degree 2n-1.
+// The recurrence coefficients are computed with the discretized Stieltjes procedure on tanh-sinh nodes,
+// so integrable singularities of w at the endpoints are allowed. n is at most 40.
+func GaussRuleForWeight(w Func1D, a, b float64, n int) (GaussRule, error) {
+	// Check input
+	if n < 1 || n > 40 || math.IsNaN(a) || math.IsNaN(b) || math.IsInf(a, 0) || math.IsInf(b, 0) || a >= b {
+		return GaussRule{}, ErrWrongInput
+	}
+
+	// Discrete measure: tanh-sinh nodes x_k with weights λ_k = tanh-sinh weight · w(x_k)
+	const h = 1. / 64
+	c, halfWidth := (a+b)/2, (b-a)/2
+	var xs, ls []float64
+	for k := -int(tanhSinhMax / h); k <= int(tanhSinhMax/h); k++ {
+		t := float64(k) * h
+		y := math.Pi / 2 * math.Sinh(math.Abs(t))
+		u := 2 / (1 + math.Exp(2*y)) // 1 - tanh|y|
+		cosh := math.Cosh(y)
+		x := b - halfWidth*u
+		if t < 0 {
+			x = a + halfWidth*u
+		}
+		if t == 0 {
+			x = c
+		}
+		if x == a || x == b {
+			continue
+		}
+		wx := w(x)
+		if wx < 0 || math.IsNaN(wx) {
+			return GaussRule{}, ErrWrongInput
+		}
+		xs = append(xs, x)
+		ls = append(ls, halfWidth*h*math.Pi/2*math.Cosh(t)/(cosh*cosh)*wx)
+	}
+
+	// Stieltjes procedure with orthonormal polynomials q_j
+	mu0 := 0.
+	for _, l := range ls {
+		mu0 += l
+	}
+	if mu0 <= 0 || math.IsInf(mu0, 0) {
+		return GaussRule{}, ErrWrongInput
+	}
+	m := len(xs)
+	prev := make([]float64, m)
+	cur := make([]float64, m)
+	for k := range cur {
+		cur[k] = 1 / math.Sqrt(mu0)
+	}
+	d := make([]float64, n)
+	e := make([]float64, n)
+	for j := range n {
+		for k := range m {
+			d[j] += ls[k] * xs[k] * cur[k] * cur[k]
+		}
+		if j == n-1 {
+			break
+		}
+		beta := 0.
+		if j > 0 {
+			beta = e[j-1]
+		}
+		next := make([]float64, m)
+		norm := 0.
+		for k := range m {
+			next[k] = (xs[k]-d[j])*cur[k] - beta*prev[k]
+			norm += ls[k] * next[k] * next[k]
+		}
+		e[j] = math.Sqrt(norm)
+		if e[j] == 0 { // The measure has fewer than n support points
+			return GaussRule{}, ErrWrongInput
+		}
+		for k := range m {
+			next[k] /= e[j]
+		}
+		prev, cur = cur, next
+	}
+
+	nodes, v0, err := tridiagonalEigen(d, e)
+	if err != nil {
+		return GaussRule{}, err
+	}
+	rule := GaussRule{Nodes: nodes, Weights: make([]float64, n)}
+	for i := range n {
+		rule.Weights[i] = mu0 * v0[i] * v0[i]
+	}
+	sortRule(rule)
+
+	return rule, nil
+}
+
+// IntegralWeighted calculates ∫[a,b] w(x)·f(x) dx with the n-point Gaussian rule for the weight w, see GaussRuleForWeight
+func IntegralWeighted(f, w Func1D, a, b float64, n int) (float64, error) {
+	rule, err := GaussRuleForWeight(w, a, b, n)
+	if err != nil {
+		return 0, err
+	}
+	return rule.Integrate(f), nil
+}
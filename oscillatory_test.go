@@ -0,0 +1,191 @@
+package numericalanalysis_test
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	numericalanalysis "github.com/Russia9/numerical-analysis"
+)
+
+func TestClenshawCurtis(t *testing.T) {
+	t.Run("ClenshawCurtisRule - Simpson weights for n = 2", func(t *testing.T) {
+		rule, err := numericalanalysis.ClenshawCurtisRule(2)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		assertSlice(t, "Nodes", rule.Nodes, []float64{-1, 0, 1}, 1e-15)
+		assertSlice(t, "Weights", rule.Weights, []float64{1. / 3, 4. / 3, 1. / 3}, 1e-15)
+	})
+
+	t.Run("ClenshawCurtisRule - exact for polynomials of degree n", func(t *testing.T) {
+		n := 9
+		rule, _ := numericalanalysis.ClenshawCurtisRule(n)
+		for k := 0; k <= n; k++ {
+			expected := 0.
+			if k%2 == 0 {
+				expected = 2 / float64(k+1)
+			}
+			got := rule.Integrate(func(x float64) float64 { return math.Pow(x, float64(k)) })
+			if math.Abs(got-expected) > 1e-14 {
+				t.Errorf("x^%d = %v, want %v", k, got, expected)
+			}
+		}
+	})
+
+	t.Run("IntegralClenshawCurtis - exponential", func(t *testing.T) {
+		res, err := numericalanalysis.IntegralClenshawCurtis(math.Exp, 0, 1, 16)
+		if err != nil || math.Abs(res-(math.E-1)) > 1e-14 {
+			t.Errorf("result = %v, err = %v, want %v", res, err, math.E-1)
+		}
+	})
+
+	t.Run("input validation", func(t *testing.T) {
+		if _, err := numericalanalysis.ClenshawCurtisRule(0); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+}
+
+func TestFilon(t *testing.T) {
+	t.Run("exact for quadratics with a single panel", func(t *testing.T) {
+		// ∫[0,2π] x² cos(ωx) and x² sin(ωx) with ω = 50.5
+		omega, b := 50.5, 2*math.Pi
+		s, c := math.Sincos(omega * b)
+		cosExpected := b*b*s/omega + 2*b*c/(omega*omega) - 2*s/(omega*omega*omega)
+		sinExpected := -b*b*c/omega + 2*b*s/(omega*omega) + 2*(c-1)/(omega*omega*omega)
+		f := func(x float64) float64 { return x * x }
+
+		cosPart, err := numericalanalysis.IntegralFilonCos(f, 0, b, omega, 2)
+		if err != nil || math.Abs(cosPart-cosExpected) > 1e-12 {
+			t.Errorf("IntegralFilonCos = %v, err = %v, want %v", cosPart, err, cosExpected)
+		}
+		sinPart, err := numericalanalysis.IntegralFilonSin(f, 0, b, omega, 2)
+		if err != nil || math.Abs(sinPart-sinExpected) > 1e-12 {
+			t.Errorf("IntegralFilonSin = %v, err = %v, want %v", sinPart, err, sinExpected)
+		}
+	})
+
+	t.Run("highly oscillatory exponential", func(t *testing.T) {
+		// ∫[0,1] e^(-x) e^(iωx) dx = (e^(-1+iω) - 1)/(-1+iω)
+		omega := 100.
+		z := complex(-1, omega)
+		expected := (cmplx.Exp(z) - 1) / z
+
+		cosPart, _ := numericalanalysis.IntegralFilonCos(func(x float64) float64 { return math.Exp(-x) }, 0, 1, omega, 20)
+		sinPart, _ := numericalanalysis.IntegralFilonSin(func(x float64) float64 { return math.Exp(-x) }, 0, 1, omega, 20)
+		if math.Abs(cosPart-real(expected)) > 1e-7 || math.Abs(sinPart-imag(expected)) > 1e-7 {
+			t.Errorf("result = %v + %vi, want %v", cosPart, sinPart, expected)
+		}
+	})
+
+	t.Run("small ω uses the series expansion", func(t *testing.T) {
+		// ω → 0: ∫[0,1] e^x cos(ωx) ≈ e - 1
+		res, _ := numericalanalysis.IntegralFilonCos(math.Exp, 0, 1, 1e-9, 100)
+		if math.Abs(res-(math.E-1)) > 1e-8 {
+			t.Errorf("result = %v, want ~%v", res, math.E-1)
+		}
+	})
+
+	t.Run("input validation", func(t *testing.T) {
+		if _, err := numericalanalysis.IntegralFilonCos(math.Exp, 0, 1, 10, 3); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+}
+
+func TestIntegralLevin(t *testing.T) {
+	g := func(x float64) float64 { return x + x*x/2 }
+	dg := func(x float64) float64 { return 1 + x }
+
+	t.Run("exact antiderivative", func(t *testing.T) {
+		// ∫[0,1] g' cos(ωg) = sin(ωg)/ω, ∫[0,1] g' sin(ωg) = (1 - cos(ωg))/ω
+		omega := 200.
+		cosPart, sinPart, err := numericalanalysis.IntegralLevin(dg, g, dg, 0, 1, omega, 4)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		s, c := math.Sincos(1.5 * omega)
+		if math.Abs(cosPart-s/omega) > 1e-12 || math.Abs(sinPart-(1-c)/omega) > 1e-12 {
+			t.Errorf("result = %v, %v, want %v, %v", cosPart, sinPart, s/omega, (1-c)/omega)
+		}
+	})
+
+	t.Run("non-linear phase against adaptive quadrature", func(t *testing.T) {
+		omega := 500.
+		f := func(x float64) float64 { return math.Exp(x) / (1 + x*x) }
+		cosPart, sinPart, err := numericalanalysis.IntegralLevin(f, g, dg, 0, 1, omega, 12)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+
+		cosRef, _ := numericalanalysis.IntegralGaussKronrod(func(x float64) float64 { return f(x) * math.Cos(omega*g(x)) }, 0, 1, 1e-13, 0, 10000)
+		sinRef, _ := numericalanalysis.IntegralGaussKronrod(func(x float64) float64 { return f(x) * math.Sin(omega*g(x)) }, 0, 1, 1e-13, 0, 10000)
+		if math.Abs(cosPart-cosRef.Value) > 1e-10 || math.Abs(sinPart-sinRef.Value) > 1e-10 {
+			t.Errorf("result = %v, %v, want %v, %v", cosPart, sinPart, cosRef.Value, sinRef.Value)
+		}
+	})
+
+	t.Run("input validation", func(t *testing.T) {
+		if _, _, err := numericalanalysis.IntegralLevin(dg, g, dg, 0, 1, 10, 1); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+}
+
+func TestGaussRuleForWeight(t *testing.T) {
+	t.Run("chebyshev weight", func(t *testing.T) {
+		// Gauss–Chebyshev: nodes cos((2i-1)π/2n), weights π/n
+		n := 5
+		rule, err := numericalanalysis.GaussRuleForWeight(func(x float64) float64 { return 1 / math.Sqrt(1-x*x) }, -1, 1, n)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		nodes := make([]float64, n)
+		weights := make([]float64, n)
+		for i := range n {
+			nodes[n-1-i] = math.Cos(float64(2*i+1) * math.Pi / float64(2*n))
+			weights[i] = math.Pi / float64(n)
+		}
+		// The weight is singular at non-zero endpoints, the accuracy is limited by the rounding of x there
+		assertSlice(t, "Nodes", rule.Nodes, nodes, 1e-7)
+		assertSlice(t, "Weights", rule.Weights, weights, 1e-7)
+	})
+
+	t.Run("singular weight at zero - exact moments", func(t *testing.T) {
+		// ∫[0,1] x^(-1/2) x^k dx = 1/(k + 1/2)
+		n := 8
+		rule, err := numericalanalysis.GaussRuleForWeight(func(x float64) float64 { return 1 / math.Sqrt(x) }, 0, 1, n)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		for k := 0; k < 2*n; k++ {
+			got := rule.Integrate(func(x float64) float64 { return math.Pow(x, float64(k)) })
+			if expected := 1 / (float64(k) + 0.5); math.Abs(got-expected) > 1e-12 {
+				t.Errorf("x^%d = %v, want %v", k, got, expected)
+			}
+		}
+	})
+
+	t.Run("IntegralWeighted - smooth weight", func(t *testing.T) {
+		// ∫[0,2] e^x cos x dx = (e²(cos 2 + sin 2) - 1)/2
+		res, err := numericalanalysis.IntegralWeighted(math.Cos, math.Exp, 0, 2, 10)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		expected := (math.Exp(2)*(math.Cos(2)+math.Sin(2)) - 1) / 2
+		if math.Abs(res-expected) > 1e-12 {
+			t.Errorf("result = %v, want %v", res, expected)
+		}
+	})
+
+	t.Run("input validation", func(t *testing.T) {
+		negative := func(x float64) float64 { return x }
+		if _, err := numericalanalysis.GaussRuleForWeight(negative, -1, 1, 3); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("negative weight: err = %v, want ErrWrongInput", err)
+		}
+		if _, err := numericalanalysis.GaussRuleForWeight(math.Exp, 1, 0, 3); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("reversed interval: err = %v, want ErrWrongInput", err)
+		}
+	})
+}
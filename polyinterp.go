@@ -0,0 +1,244 @@
+package numericalanalysis
+
+import "math"
+
+// polyinterp.go
+// Polynomial interpolation: barycentric Lagrange and Newton divided-difference forms, Chebyshev nodes
+
+// Barycentric is the interpolation polynomial through a set of points in the second (true) barycentric form
+// p(x) = Σ w_j y_j/(x - x_j) / Σ w_j/(x - x_j), which is evaluated in O(n) and is stable for good nodes
+type Barycentric struct {
+	x, y, w []float64 // Nodes, values and barycentric weights
+}
+
+// NewBarycentric builds the interpolation polynomial through the points. The points can be in any order,
+// but their X must be distinct. For many points use Chebyshev nodes, equally spaced nodes are ill-conditioned.
+func NewBarycentric(points []Point2D) (*Barycentric, error) {
+	n := len(points)
+
+	// Check input
+	if n < 1 {
+		return nil, ErrWrongInput
+	}
+
+	p := &Barycentric{x: make([]float64, n), y: make([]float64, n), w: make([]float64, n)}
+	lo, hi := points[0].X, points[0].X
+	for i, pt := range points {
+		p.x[i], p.y[i] = pt.X, pt.Y
+		lo, hi = math.Min(lo, pt.X), math.Max(hi, pt.X)
+	}
+
+	// w_j = 1/Π (x_j - x_k), scaled by the capacity of the interval to avoid overflow
+	c := 1.
+	if hi > lo {
+		c = 4 / (hi - lo)
+	}
+	for j := range n {
+		prod := 1.
+		for k := range n {
+			if k == j {
+				continue
+			}
+			if p.x[j] == p.x[k] {
+				return nil, ErrWrongInput
+			}
+			prod *= c * (p.x[j] - p.x[k])
+		}
+		p.w[j] = 1 / prod
+	}
+
+	return p, nil
+}
+
+// ChebyshevInterpolation builds the interpolation polynomial of f at n Chebyshev nodes on [a, b], see ChebyshevNodes
+func ChebyshevInterpolation(f Func1D, a, b float64, n int) (*Barycentric, error) {
+	nodes, err := ChebyshevNodes(a, b, n)
+	if err != nil {
+		return nil, err
+	}
+
+	// Closed-form weights (-1)^j sin((2j+1)π/(2n)), the nodes are in ascending order
+	p := &Barycentric{x: nodes, y: make([]float64, n), w: make([]float64, n)}
+	for j, x := range nodes {
+		p.y[j] = f(x)
+		p.w[j] = math.Sin(float64(2*j+1) * math.Pi / float64(2*n))
+		if (n-1-j)%2 == 1 {
+			p.w[j] = -p.w[j]
+		}
+	}
+	return p, nil
+}
+
+// Eval returns the value of the polynomial at x
+func (p *Barycentric) Eval(x float64) float64 {
+	num, den := 0., 0.
+	for j := range p.x {
+		if x == p.x[j] {
+			return p.y[j]
+		}
+		a := p.w[j] / (x - p.x[j])
+		num += a * p.y[j]
+		den += a
+	}
+	return num / den
+}
+
+// Derivative returns the first derivative of the polynomial at x
+func (p *Barycentric) Derivative(x float64) float64 {
+	// At a node: p'(x_i) = Σ (w_j/w_i)(y_j - y_i)/(x_i - x_j)
+	for i := range p.x {
+		if x == p.x[i] {
+			res := 0.
+			for j := range p.x {
+				if j != i {
+					res += p.w[j] / p.w[i] * (p.y[j] - p.y[i]) / (x - p.x[j])
+				}
+			}
+			return res
+		}
+	}
+
+	// Elsewhere: p'(x) = Σ a_j (p(x) - y_j)/(x - x_j) / Σ a_j, a_j = w_j/(x - x_j)
+	v := p.Eval(x)
+	num, den := 0., 0.
+	for j := range p.x {
+		a := p.w[j] / (x - p.x[j])
+		num += a * (v - p.y[j]) / (x - p.x[j])
+		den += a
+	}
+	return num / den
+}
+
+// EvalErr returns the value of the polynomial at x, or ErrOutOfDomain outside the nodes
+func (p *Barycentric) EvalErr(x float64) (float64, error) {
+	return evalInDomain(p, x)
+}
+
+// Domain returns the smallest and the largest node
+func (p *Barycentric) Domain() (float64, float64) {
+	return nodeRange(p.x)
+}
+
+// NewtonPolynomial is the interpolation polynomial in Newton form
+// p(x) = c_0 + c_1 (x - x_0) + ... + c_n (x - x_0)...(x - x_{n-1}) with divided differences c_k = f[x_0, ..., x_k].
+// Points can be added one at a time without recomputing the existing coefficients.
+type NewtonPolynomial struct {
+	x    []float64 // Nodes in the order they were added
+	coef []float64 // Divided differences f[x_0, ..., x_k]
+	last []float64 // Last row of the divided-difference table: last[k] = f[x_{n-1-k}, ..., x_{n-1}]
+}
+
+// NewNewtonPolynomial builds the interpolation polynomial through the points in Newton form.
+// The points can be in any order, but their X must be distinct.
+func NewNewtonPolynomial(points []Point2D) (*NewtonPolynomial, error) {
+	// Check input
+	if len(points) < 1 {
+		return nil, ErrWrongInput
+	}
+
+	p := &NewtonPolynomial{}
+	for _, pt := range points {
+		if err := p.Add(pt); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// Add adds a point to the polynomial in O(n), raising its degree by one
+func (p *NewtonPolynomial) Add(point Point2D) error {
+	// Check input
+	for _, x := range p.x {
+		if x == point.X {
+			return ErrWrongInput
+		}
+	}
+
+	n := len(p.x)
+	row := make([]float64, n+1)
+	row[0] = point.Y
+	for k := 1; k <= n; k++ {
+		row[k] = (row[k-1] - p.last[k-1]) / (point.X - p.x[n-k])
+	}
+
+	p.x = append(p.x, point.X)
+	p.coef = append(p.coef, row[n])
+	p.last = row
+	return nil
+}
+
+// Degree returns the degree of the polynomial, i.e. the number of points minus one
+func (p *NewtonPolynomial) Degree() int {
+	return len(p.x) - 1
+}
+
+// Eval returns the value of the polynomial at x
+func (p *NewtonPolynomial) Eval(x float64) float64 {
+	n := len(p.coef) - 1
+	res := p.coef[n]
+	for k := n - 1; k >= 0; k-- {
+		res = res*(x-p.x[k]) + p.coef[k]
+	}
+	return res
+}
+
+// Derivative returns the first derivative of the polynomial at x
+func (p *NewtonPolynomial) Derivative(x float64) float64 {
+	n := len(p.coef) - 1
+	v, d := p.coef[n], 0.
+	for k := n - 1; k >= 0; k-- {
+		d = d*(x-p.x[k]) + v
+		v = v*(x-p.x[k]) + p.coef[k]
+	}
+	return d
+}
+
+// EvalErr returns the value of the polynomial at x, or ErrOutOfDomain outside the nodes
+func (p *NewtonPolynomial) EvalErr(x float64) (float64, error) {
+	return evalInDomain(p, x)
+}
+
+// Domain returns the smallest and the largest node
+func (p *NewtonPolynomial) Domain() (float64, float64) {
+	return nodeRange(p.x)
+}
+
+// nodeRange returns the smallest and the largest of unsorted nodes
+func nodeRange(x []float64) (float64, float64) {
+	lo, hi := x[0], x[0]
+	for _, v := range x {
+		lo, hi = math.Min(lo, v), math.Max(hi, v)
+	}
+	return lo, hi
+}
+
+// ChebyshevNodes returns the n Chebyshev nodes of the first kind on [a, b] in ascending order,
+// the roots of T_n mapped to [a, b]. Interpolation at these nodes avoids the Runge phenomenon.
+func ChebyshevNodes(a, b float64, n int) ([]float64, error) {
+	// Check input
+	if n < 1 || a >= b {
+		return nil, ErrWrongInput
+	}
+
+	nodes := make([]float64, n)
+	for k := range n {
+		nodes[k] = (a+b)/2 - (b-a)/2*math.Cos(float64(2*k+1)*math.Pi/float64(2*n))
+	}
+	return nodes, nil
+}
+
+// ChebyshevExtremaNodes returns the n Chebyshev nodes of the second kind on [a, b] in ascending order,
+// the extrema of T_{n-1} mapped to [a, b]. Unlike ChebyshevNodes they include the endpoints.
+func ChebyshevExtremaNodes(a, b float64, n int) ([]float64, error) {
+	// Check input
+	if n < 2 || a >= b {
+		return nil, ErrWrongInput
+	}
+
+	nodes := make([]float64, n)
+	for k := range n {
+		nodes[k] = (a+b)/2 - (b-a)/2*math.Cos(float64(k)*math.Pi/float64(n-1))
+	}
+	nodes[0], nodes[n-1] = a, b
+	return nodes, nil
+}
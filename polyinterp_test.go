@@ -0,0 +1,180 @@
+package numericalanalysis_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	numericalanalysis "github.com/Russia9/numerical-analysis"
+)
+
+func TestNewBarycentric(t *testing.T) {
+	poly := func(x float64) float64 { return 2*x*x*x*x - x*x*x + 3*x - 5 }
+	dpoly := func(x float64) float64 { return 8*x*x*x - 3*x*x + 3 }
+
+	t.Run("reproduces a polynomial", func(t *testing.T) {
+		p, err := numericalanalysis.NewBarycentric(samples(poly, -2, 3, 6, 8))
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		for _, x := range []float64{-2, -1.3, 0, 0.77, 2.5, 3, 4} {
+			if got := p.Eval(x); math.Abs(got-poly(x)) > 1e-10*math.Max(1, math.Abs(poly(x))) {
+				t.Errorf("Eval(%v) = %v, want %v", x, got, poly(x))
+			}
+			if got := p.Derivative(x); math.Abs(got-dpoly(x)) > 1e-8*math.Max(1, math.Abs(dpoly(x))) {
+				t.Errorf("Derivative(%v) = %v, want %v", x, got, dpoly(x))
+			}
+		}
+	})
+
+	t.Run("agrees with Lagrange interpolation", func(t *testing.T) {
+		points := samples(math.Cos, 0, 2, 8, 9)
+		p, err := numericalanalysis.NewBarycentric(points)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		l := numericalanalysis.LagrangeInterpolation1D(points)
+		for x := 0.; x <= 2; x += 0.1 {
+			if got, want := p.Eval(x), l(x); math.Abs(got-want) > 1e-12 {
+				t.Errorf("Eval(%v) = %v, want %v", x, got, want)
+			}
+		}
+	})
+
+	t.Run("wrong input", func(t *testing.T) {
+		for name, points := range map[string][]numericalanalysis.Point2D{
+			"no points":   nil,
+			"duplicate x": {{X: 0, Y: 1}, {X: 1, Y: 2}, {X: 0, Y: 3}},
+		} {
+			if _, err := numericalanalysis.NewBarycentric(points); !errors.Is(err, numericalanalysis.ErrWrongInput) {
+				t.Errorf("%s: err = %v, want %v", name, err, numericalanalysis.ErrWrongInput)
+			}
+		}
+	})
+}
+
+func TestChebyshevInterpolation(t *testing.T) {
+	t.Run("no Runge phenomenon", func(t *testing.T) {
+		runge := func(x float64) float64 { return 1 / (1 + 25*x*x) }
+		p, err := numericalanalysis.ChebyshevInterpolation(runge, -1, 1, 101)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		for x := -1.; x <= 1; x += 0.01 {
+			if got := p.Eval(x); math.Abs(got-runge(x)) > 1e-7 {
+				t.Errorf("Eval(%v) = %v, want %v", x, got, runge(x))
+			}
+		}
+	})
+
+	t.Run("matches generic weights", func(t *testing.T) {
+		nodes, err := numericalanalysis.ChebyshevNodes(1, 3, 12)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		points := make([]numericalanalysis.Point2D, len(nodes))
+		for i, x := range nodes {
+			points[i] = numericalanalysis.Point2D{X: x, Y: math.Log(x)}
+		}
+		generic, err := numericalanalysis.NewBarycentric(points)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		p, err := numericalanalysis.ChebyshevInterpolation(math.Log, 1, 3, 12)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		for x := 1.; x <= 3; x += 0.05 {
+			if got, want := p.Eval(x), generic.Eval(x); math.Abs(got-want) > 1e-13 {
+				t.Errorf("Eval(%v) = %v, want %v", x, got, want)
+			}
+			if got, want := p.Derivative(x), 1/x; math.Abs(got-want) > 1e-5 {
+				t.Errorf("Derivative(%v) = %v, want %v", x, got, want)
+			}
+		}
+	})
+}
+
+func TestChebyshevNodes(t *testing.T) {
+	t.Run("first kind", func(t *testing.T) {
+		nodes, err := numericalanalysis.ChebyshevNodes(-1, 1, 3)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		assertSlice(t, "nodes", nodes, []float64{-math.Sqrt(3) / 2, 0, math.Sqrt(3) / 2}, 1e-15)
+	})
+
+	t.Run("second kind", func(t *testing.T) {
+		nodes, err := numericalanalysis.ChebyshevExtremaNodes(0, 4, 5)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		assertSlice(t, "nodes", nodes, []float64{0, 2 - math.Sqrt2, 2, 2 + math.Sqrt2, 4}, 1e-15)
+	})
+
+	t.Run("wrong input", func(t *testing.T) {
+		if _, err := numericalanalysis.ChebyshevNodes(1, 0, 3); !errors.Is(err, numericalanalysis.ErrWrongInput) {
+			t.Errorf("err = %v, want %v", err, numericalanalysis.ErrWrongInput)
+		}
+		if _, err := numericalanalysis.ChebyshevExtremaNodes(0, 1, 1); !errors.Is(err, numericalanalysis.ErrWrongInput) {
+			t.Errorf("err = %v, want %v", err, numericalanalysis.ErrWrongInput)
+		}
+	})
+}
+
+func TestNewNewtonPolynomial(t *testing.T) {
+	t.Run("incremental points", func(t *testing.T) {
+		// Adding points of x³ raises the degree until the cubic is reproduced exactly
+		cube := func(x float64) float64 { return x * x * x }
+		p, err := numericalanalysis.NewNewtonPolynomial([]numericalanalysis.Point2D{{X: 1, Y: 1}, {X: -1, Y: -1}})
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if got := p.Eval(3); got != 3 {
+			t.Errorf("line: Eval(3) = %v, want 3", got)
+		}
+		for _, x := range []float64{2, 0.5, -3} {
+			if err := p.Add(numericalanalysis.Point2D{X: x, Y: cube(x)}); err != nil {
+				t.Fatalf("Add(%v): err = %v, want nil", x, err)
+			}
+		}
+		if p.Degree() != 4 {
+			t.Errorf("Degree() = %v, want 4", p.Degree())
+		}
+		for _, x := range []float64{-2.5, 0, 1.7, 4} {
+			if got := p.Eval(x); math.Abs(got-cube(x)) > 1e-12 {
+				t.Errorf("Eval(%v) = %v, want %v", x, got, cube(x))
+			}
+			if got := p.Derivative(x); math.Abs(got-3*x*x) > 1e-12 {
+				t.Errorf("Derivative(%v) = %v, want %v", x, got, 3*x*x)
+			}
+		}
+	})
+
+	t.Run("agrees with barycentric form", func(t *testing.T) {
+		points := samples(math.Exp, -1, 1, 7, 10)
+		p, err := numericalanalysis.NewNewtonPolynomial(points)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		b, err := numericalanalysis.NewBarycentric(points)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		for x := -1.; x <= 1; x += 0.1 {
+			if got, want := p.Eval(x), b.Eval(x); math.Abs(got-want) > 1e-12 {
+				t.Errorf("Eval(%v) = %v, want %v", x, got, want)
+			}
+		}
+	})
+
+	t.Run("wrong input", func(t *testing.T) {
+		if _, err := numericalanalysis.NewNewtonPolynomial(nil); !errors.Is(err, numericalanalysis.ErrWrongInput) {
+			t.Errorf("err = %v, want %v", err, numericalanalysis.ErrWrongInput)
+		}
+		p, _ := numericalanalysis.NewNewtonPolynomial([]numericalanalysis.Point2D{{X: 0, Y: 0}})
+		if err := p.Add(numericalanalysis.Point2D{X: 0, Y: 1}); !errors.Is(err, numericalanalysis.ErrWrongInput) {
+			t.Errorf("Add: err = %v, want %v", err, numericalanalysis.ErrWrongInput)
+		}
+	})
+}
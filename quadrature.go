@@ -0,0 +1,181 @@
+package numericalanalysis
+
+import "math"
+
+// quadrature.go
+// Adaptive quadrature with error estimates
+
+// QuadratureResult is the outcome of an integration with an error estimate
+type QuadratureResult struct {
+	Value float64 // Integral estimate
+	Error float64 // Estimated absolute error
+	Evals int     // Number of function evaluations
+}
+
+// quadInterval is a subinterval of an adaptive quadrature
+type quadInterval struct {
+	a, b  float64
+	value float64
+	err   float64
+	fx    [5]float64 // f at a, a+h/4, a+h/2, a+3h/4, b (adaptive Simpson only)
+}
+
+// IntegralAdaptiveSimpson calculates the integral of f over [a, b] with the adaptive Simpson rule.
+// The subinterval with the largest error is bisected until the total error is below max(absTol, relTol·|I|).
+// On ErrDidNotConverge the result holds the estimate reached after maxSubdivisions subdivisions.
+// absTol, relTol: absolute and relative tolerances, at least one of them positive
+// maxSubdivisions: maximum number of subintervals
+func IntegralAdaptiveSimpson(f Func1D, a, b, absTol, relTol float64, maxSubdivisions int) (QuadratureResult, error) {
+	return adaptiveQuadrature(f, a, b, absTol, relTol, maxSubdivisions, simpsonInterval, splitSimpson)
+}
+
+// IntegralGaussKronrod calculates the integral of f over [a, b] with the adaptive 7-point Gauss / 15-point Kronrod rule.
+// The error of a subinterval is estimated as the difference of the Gauss and Kronrod results.
+// On ErrDidNotConverge the result holds the estimate reached after maxSubdivisions subdivisions.
+// absTol, relTol: absolute and relative tolerances, at least one of them positive
+// maxSubdivisions: maximum number of subintervals
+func IntegralGaussKronrod(f Func1D, a, b, absTol, relTol float64, maxSubdivisions int) (QuadratureResult, error) {
+	split := func(f Func1D, iv quadInterval) (quadInterval, quadInterval) {
+		m := (iv.a + iv.b) / 2
+		return gaussKronrodInterval(f, iv.a, m), gaussKronrodInterval(f, m, iv.b)
+	}
+	return adaptiveQuadrature(f, a, b, absTol, relTol, maxSubdivisions, gaussKronrodInterval, split)
+}
+
+// adaptiveQuadrature runs a globally adaptive bisection with the given rule on the initial interval and on splits
+func adaptiveQuadrature(f Func1D, a, b, absTol, relTol float64, maxSubdivisions int,
+	initial func(f Func1D, a, b float64) quadInterval,
+	split func(f Func1D, iv quadInterval) (quadInterval, quadInterval)) (QuadratureResult, error) {
+	// Check input
+	if math.IsNaN(a) || math.IsNaN(b) || math.IsInf(a, 0) || math.IsInf(b, 0) ||
+		absTol < 0 || relTol < 0 || (absTol == 0 && relTol == 0) || maxSubdivisions < 1 {
+		return QuadratureResult{}, ErrWrongInput
+	}
+	if a == b {
+		return QuadratureResult{}, nil
+	}
+	sign := 1.
+	if b < a {
+		a, b, sign = b, a, -1
+	}
+
+	// Count evaluations
+	evals := 0
+	g := func(x float64) float64 {
+		evals++
+		return f(x)
+	}
+
+	intervals := []quadInterval{initial(g, a, b)}
+	result := func() QuadratureResult {
+		res := QuadratureResult{Evals: evals}
+		for _, iv := range intervals {
+			res.Value += iv.value
+			res.Error += iv.err
+		}
+		res.Value *= sign
+		return res
+	}
+
+	for {
+		res := result()
+		if res.Error <= math.Max(absTol, relTol*math.Abs(res.Value)) {
+			return res, nil
+		}
+		if len(intervals) >= maxSubdivisions {
+			return res, ErrDidNotConverge
+		}
+
+		// Bisect the interval with the largest error
+		worst := 0
+		for i := range intervals {
+			if intervals[i].err > intervals[worst].err {
+				worst = i
+			}
+		}
+		iv := intervals[worst]
+		if m := (iv.a + iv.b) / 2; m <= iv.a || m >= iv.b { // Interval can not be split further
+			return res, ErrDidNotConverge
+		}
+		left, right := split(g, iv)
+		intervals[worst] = left
+		intervals = append(intervals, right)
+	}
+}
+
+// simpsonInterval applies Simpson's rule on [a, b] and on both of its halves
+func simpsonInterval(f Func1D, a, b float64) quadInterval {
+	h := b - a
+	return simpsonFromValues(a, b, [5]float64{f(a), f(a + h/4), f(a + h/2), f(a + 3*h/4), f(b)})
+}
+
+// simpsonFromValues builds a Simpson interval from the five equally spaced function values
+func simpsonFromValues(a, b float64, fx [5]float64) quadInterval {
+	h := b - a
+	whole := h / 6 * (fx[0] + 4*fx[2] + fx[4])
+	halves := h / 12 * (fx[0] + 4*fx[1] + 2*fx[2] + 4*fx[3] + fx[4])
+	return quadInterval{
+		a:     a,
+		b:     b,
+		value: halves + (halves-whole)/15, // Richardson extrapolation
+		err:   math.Abs(halves-whole) / 15,
+		fx:    fx,
+	}
+}
+
+// splitSimpson bisects a Simpson interval reusing the known function values (4 new evaluations)
+func splitSimpson(f Func1D, iv quadInterval) (quadInterval, quadInterval) {
+	m := (iv.a + iv.b) / 2
+	h := iv.b - iv.a
+	left := [5]float64{iv.fx[0], f(iv.a + h/8), iv.fx[1], f(iv.a + 3*h/8), iv.fx[2]}
+	right := [5]float64{iv.fx[2], f(m + h/8), iv.fx[3], f(m + 3*h/8), iv.fx[4]}
+	return simpsonFromValues(iv.a, m, left), simpsonFromValues(m, iv.b, right)
+}
+
+// Nodes and weights of the 15-point Kronrod rule on [-1, 1]; odd nodes are the 7-point Gauss nodes
+var (
+	kronrodNodes = [8]float64{
+		0.991455371120812639206854697526329,
+		0.949107912342758524526189684047851,
+		0.864864423359769072789712788640926,
+		0.741531185599394439863864773280788,
+		0.586087235467691130294144845693013,
+		0.405845151377397166906606412076961,
+		0.207784955007898467600689403773245,
+		0,
+	}
+	kronrodWeights = [8]float64{
+		0.022935322010529224963732008058970,
+		0.063092092629978553290700663189204,
+		0.104790010322250183839876322541518,
+		0.140653259715525918745189590510238,
+		0.169004726639267902826583426598550,
+		0.190350578064785409913256402421014,
+		0.204432940075298892414161999234649,
+		0.209482141084727828012999174891714,
+	}
+	gauss7Weights = [4]float64{
+		0.129484966168869693270611432679082,
+		0.279705391489276667901467771423780,
+		0.381830050505118944950369775488975,
+		0.417959183673469387755102040816327,
+	}
+)
+
+// gaussKronrodInterval applies the G7K15 pair on [a, b]
+func gaussKronrodInterval(f Func1D, a, b float64) quadInterval {
+	c, h := (a+b)/2, (b-a)/2
+
+	fc := f(c)
+	kronrod := kronrodWeights[7] * fc
+	gauss := gauss7Weights[3] * fc
+	for i := range 7 {
+		sum := f(c-h*kronrodNodes[i]) + f(c+h*kronrodNodes[i])
+		kronrod += kronrodWeights[i] * sum
+		if i%2 == 1 {
+			gauss += gauss7Weights[i/2] * sum
+		}
+	}
+
+	return quadInterval{a: a, b: b, value: kronrod * h, err: math.Abs(kronrod-gauss) * h}
+}
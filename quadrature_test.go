@@ -0,0 +1,121 @@
+package numericalanalysis_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	numericalanalysis "github.com/Russia9/numerical-analysis"
+)
+
+func TestAdaptiveQuadrature(t *testing.T) {
+	methods := map[string]func(f numericalanalysis.Func1D, a, b, absTol, relTol float64, maxSubdivisions int) (numericalanalysis.QuadratureResult, error){
+		"IntegralAdaptiveSimpson": numericalanalysis.IntegralAdaptiveSimpson,
+		"IntegralGaussKronrod":    numericalanalysis.IntegralGaussKronrod,
+	}
+
+	tests := map[string]struct {
+		f        numericalanalysis.Func1D
+		a, b     float64
+		expected float64
+	}{
+		// ∫[0,π] sin x dx = 2
+		"sine": {f: math.Sin, a: 0, b: math.Pi, expected: 2},
+		// ∫[0,1] √x dx = 2/3, derivative singular at 0
+		"square root": {f: math.Sqrt, a: 0, b: 1, expected: 2. / 3},
+		// ∫[-1,1] 1/(1+25x²) dx = 2/5 arctan 5
+		"runge function": {f: func(x float64) float64 { return 1 / (1 + 25*x*x) }, a: -1, b: 1, expected: 0.4 * math.Atan(5)},
+		// ∫[0,1] e^x dx = e - 1
+		"exponential": {f: math.Exp, a: 0, b: 1, expected: math.E - 1},
+		// ∫[0,2] |x-1| dx = 1
+		"kink": {f: func(x float64) float64 { return math.Abs(x - 1) }, a: 0, b: 2, expected: 1},
+	}
+
+	for methodName, integrate := range methods {
+		for name, test := range tests {
+			t.Run(methodName+" - "+name, func(t *testing.T) {
+				res, err := integrate(test.f, test.a, test.b, 1e-10, 0, 10000)
+				if err != nil {
+					t.Fatalf("err = %v, want nil", err)
+				}
+				if math.Abs(res.Value-test.expected) > 1e-9 {
+					t.Errorf("Value = %v, want ~%v", res.Value, test.expected)
+				}
+				if res.Error > 1e-10 || res.Evals == 0 {
+					t.Errorf("Error = %v, Evals = %v", res.Error, res.Evals)
+				}
+			})
+		}
+
+		t.Run(methodName+" - reversed limits", func(t *testing.T) {
+			res, err := integrate(math.Sin, math.Pi, 0, 1e-10, 0, 1000)
+			if err != nil {
+				t.Fatalf("err = %v, want nil", err)
+			}
+			if math.Abs(res.Value+2) > 1e-9 {
+				t.Errorf("Value = %v, want ~-2", res.Value)
+			}
+		})
+
+		t.Run(methodName+" - relative tolerance", func(t *testing.T) {
+			// ∫[0,1] 1e6·cos x dx = 1e6·sin 1
+			f := func(x float64) float64 { return 1e6 * math.Cos(x) }
+			res, err := integrate(f, 0, 1, 0, 1e-12, 1000)
+			if err != nil {
+				t.Fatalf("err = %v, want nil", err)
+			}
+			if math.Abs(res.Value-1e6*math.Sin(1)) > 1e-5 {
+				t.Errorf("Value = %v, want ~%v", res.Value, 1e6*math.Sin(1))
+			}
+		})
+
+		t.Run(methodName+" - empty interval", func(t *testing.T) {
+			res, err := integrate(math.Sin, 1, 1, 1e-10, 0, 10)
+			if err != nil || res.Value != 0 {
+				t.Errorf("result = %+v, err = %v, want 0, nil", res, err)
+			}
+		})
+
+		t.Run(methodName+" - subdivision limit", func(t *testing.T) {
+			// ∫[0,1] sin(1/x) dx oscillates infinitely fast near 0
+			f := func(x float64) float64 { return math.Sin(1 / x) }
+			res, err := integrate(f, 1e-6, 1, 1e-14, 0, 20)
+			if !errors.Is(err, numericalanalysis.ErrDidNotConverge) {
+				t.Errorf("err = %v, want ErrDidNotConverge", err)
+			}
+			if res.Evals == 0 || res.Error == 0 {
+				t.Errorf("result = %+v, want partial estimate", res)
+			}
+		})
+
+		t.Run(methodName+" - input validation", func(t *testing.T) {
+			invalid := []struct {
+				a, b, absTol, relTol float64
+				maxSubdivisions      int
+			}{
+				{0, math.Inf(1), 1e-8, 0, 10},
+				{math.NaN(), 1, 1e-8, 0, 10},
+				{0, 1, 0, 0, 10},
+				{0, 1, -1, 0, 10},
+				{0, 1, 1e-8, 0, 0},
+			}
+			for _, in := range invalid {
+				_, err := integrate(math.Sin, in.a, in.b, in.absTol, in.relTol, in.maxSubdivisions)
+				if err != numericalanalysis.ErrWrongInput {
+					t.Errorf("%+v: err = %v, want ErrWrongInput", in, err)
+				}
+			}
+		})
+	}
+
+	t.Run("IntegralGaussKronrod - single interval is exact for polynomials of degree 13", func(t *testing.T) {
+		f := func(x float64) float64 { return math.Pow(x, 13) + math.Pow(x, 12) }
+		res, err := numericalanalysis.IntegralGaussKronrod(f, 0, 1, 1e-12, 0, 1)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(res.Value-(1./14+1./13)) > 1e-14 || res.Evals != 15 {
+			t.Errorf("result = %+v, want %v with 15 evaluations", res, 1./14+1./13)
+		}
+	})
+}
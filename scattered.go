@@ -0,0 +1,387 @@
+package numericalanalysis
+
+import "math"
+
+// scattered.go
+// Scattered-data interpolation: Delaunay triangulation, inverse-distance weighting and radial basis functions
+
+// Delaunay returns the Delaunay triangulation of the points as triples of indices into points,
+// each in counter-clockwise order. It uses the Bowyer–Watson algorithm with a ghost vertex at infinity outside
+// every hull edge instead of a finite super triangle, so the hull is kept however flat it is.
+// The points must be distinct and not all collinear.
+func Delaunay(points []Point2D) ([][3]int, error) {
+	n := len(points)
+
+	// Check input
+	if n < 3 {
+		return nil, ErrWrongInput
+	}
+	seen := map[Point2D]bool{}
+	for _, p := range points {
+		if seen[p] || math.IsNaN(p.X) || math.IsNaN(p.Y) || math.IsInf(p.X, 0) || math.IsInf(p.Y, 0) {
+			return nil, ErrWrongInput
+		}
+		seen[p] = true
+	}
+
+	// Start from the first three points that are not collinear. Outside every hull edge ab lies the ghost triangle
+	// (a, b, ghost), with the ghost vertex at infinity and the edge counter-clockwise as seen from it.
+	first := [3]int{0, 1, -1}
+	for k := 2; k < n && first[2] < 0; k++ {
+		if orientation(points[0], points[1], points[k]) != 0 {
+			first[2] = k
+		}
+	}
+	if first[2] < 0 { // All points are collinear
+		return nil, ErrWrongInput
+	}
+	first = counterClockwise(points, first)
+	ghost := n
+	triangles := [][3]int{first, {first[1], first[0], ghost}, {first[2], first[1], ghost}, {first[0], first[2], ghost}}
+
+	// conflicts reports whether p lies inside the circumcircle of the triangle. The circumcircle of a ghost triangle
+	// is the open half-plane outside its hull edge together with the open edge, so it is decided without the ghost vertex.
+	conflicts := func(t [3]int, p Point2D) bool {
+		if t[2] != ghost {
+			return inCircumcircle(points[t[0]], points[t[1]], points[t[2]], p)
+		}
+		a, b := points[t[0]], points[t[1]]
+		if o := orientation(a, b, p); o != 0 {
+			return o > 0
+		}
+		return (p.X-a.X)*(p.X-b.X)+(p.Y-a.Y)*(p.Y-b.Y) < 0
+	}
+
+	for i := range n {
+		if i == first[0] || i == first[1] || i == first[2] {
+			continue
+		}
+
+		// Remove the triangles whose circumcircle contains the point and remember their directed edges
+		edges := map[[2]int]bool{}
+		kept := triangles[:0]
+		for _, t := range triangles {
+			if !conflicts(t, points[i]) {
+				kept = append(kept, t)
+				continue
+			}
+			for k := range 3 {
+				edges[[2]int{t[k], t[(k+1)%3]}] = true
+			}
+		}
+		triangles = kept
+
+		// Connect the point to the boundary of the hole, the edges whose reverse belongs to no removed triangle,
+		// keeping the ghost vertex last
+		for e := range edges {
+			switch {
+			case edges[[2]int{e[1], e[0]}]:
+			case e[0] == ghost:
+				triangles = append(triangles, [3]int{e[1], i, ghost})
+			case e[1] == ghost:
+				triangles = append(triangles, [3]int{i, e[0], ghost})
+			default:
+				triangles = append(triangles, [3]int{e[0], e[1], i})
+			}
+		}
+	}
+
+	// Remove the ghost triangles
+	res := make([][3]int, 0, len(triangles))
+	for _, t := range triangles {
+		if t[2] != ghost {
+			res = append(res, t)
+		}
+	}
+
+	return res, nil
+}
+
+// DelaunayInterpolation2D builds the piecewise linear interpolant over the Delaunay triangulation of the points.
+// It returns NaN outside the convex hull of the points.
+func DelaunayInterpolation2D(points []Point3D) (Func2D, error) {
+	flat := make([]Point2D, len(points))
+	z := make([]float64, len(points))
+	for i, p := range points {
+		flat[i], z[i] = Point2D{X: p.X, Y: p.Y}, p.Z
+	}
+
+	triangles, err := Delaunay(flat)
+	if err != nil {
+		return nil, err
+	}
+	locator := newTriangleLocator(flat, triangles)
+
+	return func(x, y float64) float64 {
+		t, l, ok := locator.find(Point2D{X: x, Y: y})
+		if !ok {
+			return math.NaN()
+		}
+		return l[0]*z[t[0]] + l[1]*z[t[1]] + l[2]*z[t[2]]
+	}, nil
+}
+
+// IDWInterpolation builds Shepard's inverse-distance weighting interpolant of N-D scattered data:
+// a weighted mean of the values with weights 1/d^power, d being the distance to the point
+// points[m][d]: coordinates of the points
+// values[m]: values at the points
+// power: power of the distance, > 0; 2 is the usual choice
+func IDWInterpolation(points [][]float64, values []float64, power float64) (func(x []float64) float64, error) {
+	pts, err := scatteredPoints(points, values)
+	if err != nil {
+		return nil, err
+	}
+	if !(power > 0) {
+		return nil, ErrWrongInput
+	}
+	vals := append([]float64(nil), values...)
+
+	return func(x []float64) float64 {
+		num, den := 0., 0.
+		for i, p := range pts {
+			d := distance(x, p)
+			if d == 0 {
+				return vals[i]
+			}
+			w := math.Pow(d, -power)
+			num += w * vals[i]
+			den += w
+		}
+		return num / den
+	}, nil
+}
+
+// IDWInterpolation2D builds the inverse-distance weighting interpolant of the points, see IDWInterpolation
+func IDWInterpolation2D(points []Point3D, power float64) (Func2D, error) {
+	pts, values := splitPoints3D(points)
+	f, err := IDWInterpolation(pts, values, power)
+	if err != nil {
+		return nil, err
+	}
+	return func(x, y float64) float64 { return f([]float64{x, y}) }, nil
+}
+
+// RadialBasis is a radial basis function φ(r)
+type RadialBasis int
+
+const (
+	GaussianRBF     RadialBasis = iota // exp(-(εr)²)
+	MultiquadricRBF                    // sqrt(1 + (εr)²)
+	ThinPlateRBF                       // r² log r, with a linear polynomial added; ε is ignored
+)
+
+// RBFInterpolation builds the radial basis function interpolant of N-D scattered data
+// s(x) = Σ λ_i φ(|x - x_i|) (+ a linear polynomial for ThinPlateRBF) by solving the dense interpolation system
+// points[m][d]: coordinates of the points
+// values[m]: values at the points
+// epsilon: shape parameter of GaussianRBF and MultiquadricRBF, > 0; smaller values give flatter and smoother
+// but worse conditioned interpolants
+func RBFInterpolation(points [][]float64, values []float64, basis RadialBasis, epsilon float64) (func(x []float64) float64, error) {
+	pts, err := scatteredPoints(points, values)
+	if err != nil {
+		return nil, err
+	}
+
+	var phi func(r float64) float64
+	switch basis {
+	case GaussianRBF:
+		phi = func(r float64) float64 { return math.Exp(-(epsilon * r) * (epsilon * r)) }
+	case MultiquadricRBF:
+		phi = func(r float64) float64 { return math.Sqrt(1 + (epsilon*r)*(epsilon*r)) }
+	case ThinPlateRBF:
+		phi = func(r float64) float64 {
+			if r == 0 {
+				return 0
+			}
+			return r * r * math.Log(r)
+		}
+	default:
+		return nil, ErrWrongInput
+	}
+	if basis != ThinPlateRBF && !(epsilon > 0) {
+		return nil, ErrWrongInput
+	}
+
+	// [Φ P; Pᵀ 0] [λ; c] = [values; 0], P = [1 x] for the thin-plate spline
+	m, d := len(pts), len(pts[0])
+	poly := 0
+	if basis == ThinPlateRBF {
+		poly = d + 1
+		if m < poly {
+			return nil, ErrWrongInput
+		}
+	}
+	A := make(Matrix, m+poly)
+	free := make([]float64, m+poly)
+	for i := range A {
+		A[i] = make([]float64, m+poly)
+	}
+	for i := range m {
+		for j := range m {
+			A[i][j] = phi(distance(pts[i], pts[j]))
+		}
+		if poly > 0 {
+			A[i][m], A[m][i] = 1, 1
+			for k := range d {
+				A[i][m+1+k], A[m+1+k][i] = pts[i][k], pts[i][k]
+			}
+		}
+		free[i] = values[i]
+	}
+
+	coef, err := GaussianElimination(A, free)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(x []float64) float64 {
+		res := 0.
+		for i, p := range pts {
+			res += coef[i] * phi(distance(x, p))
+		}
+		if poly > 0 {
+			res += coef[m]
+			for k := range d {
+				res += coef[m+1+k] * x[k]
+			}
+		}
+		return res
+	}, nil
+}
+
+// RBFInterpolation2D builds the radial basis function interpolant of the points, see RBFInterpolation
+func RBFInterpolation2D(points []Point3D, basis RadialBasis, epsilon float64) (Func2D, error) {
+	pts, values := splitPoints3D(points)
+	f, err := RBFInterpolation(pts, values, basis, epsilon)
+	if err != nil {
+		return nil, err
+	}
+	return func(x, y float64) float64 { return f([]float64{x, y}) }, nil
+}
+
+// scatteredPoints checks N-D scattered data and returns a copy of the points
+func scatteredPoints(points [][]float64, values []float64) ([][]float64, error) {
+	// Check input
+	if len(points) == 0 || len(points) != len(values) || len(points[0]) == 0 {
+		return nil, ErrWrongInput
+	}
+
+	pts := make([][]float64, len(points))
+	for i, p := range points {
+		if len(p) != len(points[0]) {
+			return nil, ErrWrongInput
+		}
+		pts[i] = append([]float64(nil), p...)
+	}
+	return pts, nil
+}
+
+// splitPoints3D returns the X, Y coordinates and the Z values of the points
+func splitPoints3D(points []Point3D) ([][]float64, []float64) {
+	pts := make([][]float64, len(points))
+	values := make([]float64, len(points))
+	for i, p := range points {
+		pts[i], values[i] = []float64{p.X, p.Y}, p.Z
+	}
+	return pts, values
+}
+
+// distance returns the Euclidean distance between x and y
+func distance(x, y []float64) float64 {
+	res := 0.
+	for i := range x {
+		res += (x[i] - y[i]) * (x[i] - y[i])
+	}
+	return math.Sqrt(res)
+}
+
+// inCircumcircle reports whether d lies strictly inside the circumcircle of the triangle abc
+func inCircumcircle(a, b, c, d Point2D) bool {
+	ax, ay := a.X-d.X, a.Y-d.Y
+	bx, by := b.X-d.X, b.Y-d.Y
+	cx, cy := c.X-d.X, c.Y-d.Y
+	det := (ax*ax+ay*ay)*(bx*cy-cx*by) - (bx*bx+by*by)*(ax*cy-cx*ay) + (cx*cx+cy*cy)*(ax*by-bx*ay)
+	if orientation(a, b, c) < 0 {
+		det = -det
+	}
+	return det > 0
+}
+
+// orientation returns twice the signed area of the triangle abc, positive if it is counter-clockwise
+func orientation(a, b, c Point2D) float64 {
+	return (b.X-a.X)*(c.Y-a.Y) - (c.X-a.X)*(b.Y-a.Y)
+}
+
+// counterClockwise orders the vertices of the triangle counter-clockwise
+func counterClockwise(pts []Point2D, t [3]int) [3]int {
+	if orientation(pts[t[0]], pts[t[1]], pts[t[2]]) < 0 {
+		t[1], t[2] = t[2], t[1]
+	}
+	return t
+}
+
+// triangleLocator finds the triangle containing a point with a uniform grid of buckets over the triangles
+type triangleLocator struct {
+	pts       []Point2D
+	triangles [][3]int
+	lo        Point2D   // Lower left corner of the grid
+	cell      Point2D   // Size of a bucket
+	nx, ny    int       // Number of buckets along each axis
+	buckets   [][]int32 // Triangles whose bounding box overlaps the bucket
+}
+
+func newTriangleLocator(pts []Point2D, triangles [][3]int) *triangleLocator {
+	l := &triangleLocator{pts: pts, triangles: triangles}
+	lo, hi := pts[0], pts[0]
+	for _, p := range pts {
+		lo = Point2D{X: math.Min(lo.X, p.X), Y: math.Min(lo.Y, p.Y)}
+		hi = Point2D{X: math.Max(hi.X, p.X), Y: math.Max(hi.Y, p.Y)}
+	}
+	side := max(1, int(math.Sqrt(float64(len(triangles)))))
+	l.lo, l.nx, l.ny = lo, side, side
+	l.cell = Point2D{X: (hi.X - lo.X) / float64(side), Y: (hi.Y - lo.Y) / float64(side)}
+	l.buckets = make([][]int32, side*side)
+
+	for k, t := range triangles {
+		bLo, bHi := pts[t[0]], pts[t[0]]
+		for _, v := range t[1:] {
+			bLo = Point2D{X: math.Min(bLo.X, pts[v].X), Y: math.Min(bLo.Y, pts[v].Y)}
+			bHi = Point2D{X: math.Max(bHi.X, pts[v].X), Y: math.Max(bHi.Y, pts[v].Y)}
+		}
+		i0, j0 := l.bucket(bLo)
+		i1, j1 := l.bucket(bHi)
+		for i := i0; i <= i1; i++ {
+			for j := j0; j <= j1; j++ {
+				l.buckets[i*l.ny+j] = append(l.buckets[i*l.ny+j], int32(k))
+			}
+		}
+	}
+	return l
+}
+
+// bucket returns the indices of the bucket containing p, clamped to the grid
+func (l *triangleLocator) bucket(p Point2D) (int, int) {
+	index := func(v, lo, cell float64, n int) int {
+		if cell == 0 {
+			return 0
+		}
+		return min(max(int((v-lo)/cell), 0), n-1)
+	}
+	return index(p.X, l.lo.X, l.cell.X, l.nx), index(p.Y, l.lo.Y, l.cell.Y, l.ny)
+}
+
+// find returns the triangle containing p and the barycentric coordinates of p in it
+func (l *triangleLocator) find(p Point2D) ([3]int, [3]float64, bool) {
+	i, j := l.bucket(p)
+	for _, k := range l.buckets[i*l.ny+j] {
+		t := l.triangles[k]
+		a, b, c := l.pts[t[0]], l.pts[t[1]], l.pts[t[2]]
+		area := orientation(a, b, c)
+		lambda := [3]float64{orientation(p, b, c) / area, orientation(a, p, c) / area, orientation(a, b, p) / area}
+		const tol = -1e-12
+		if lambda[0] >= tol && lambda[1] >= tol && lambda[2] >= tol {
+			return t, lambda, true
+		}
+	}
+	return [3]int{}, [3]float64{}, false
+}
@@ -0,0 +1,273 @@
+package numericalanalysis_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	numericalanalysis "github.com/Russia9/numerical-analysis"
+)
+
+// scatter returns the corners of the unit square and n random points inside it
+func scatter(n int, seed int64) []numericalanalysis.Point2D {
+	rng := rand.New(rand.NewSource(seed))
+	points := []numericalanalysis.Point2D{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 0, Y: 1}, {X: 1, Y: 1}}
+	for range n {
+		points = append(points, numericalanalysis.Point2D{X: rng.Float64(), Y: rng.Float64()})
+	}
+	return points
+}
+
+// lift adds the values of f to the points
+func lift(points []numericalanalysis.Point2D, f numericalanalysis.Func2D) []numericalanalysis.Point3D {
+	res := make([]numericalanalysis.Point3D, len(points))
+	for i, p := range points {
+		res[i] = numericalanalysis.Point3D{X: p.X, Y: p.Y, Z: f(p.X, p.Y)}
+	}
+	return res
+}
+
+func TestDelaunay(t *testing.T) {
+	t.Run("empty circumcircles", func(t *testing.T) {
+		points := scatter(200, 17)
+		triangles, err := numericalanalysis.Delaunay(points)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+
+		// The triangles are counter-clockwise and cover the unit square
+		area := 0.
+		for _, tr := range triangles {
+			a, b, c := points[tr[0]], points[tr[1]], points[tr[2]]
+			s := ((b.X-a.X)*(c.Y-a.Y) - (c.X-a.X)*(b.Y-a.Y)) / 2
+			if s <= 0 {
+				t.Fatalf("triangle %v is not counter-clockwise", tr)
+			}
+			area += s
+		}
+		if math.Abs(area-1) > 1e-12 {
+			t.Errorf("area = %v, want 1", area)
+		}
+
+		// No point lies inside the circumcircle of a triangle
+		for _, tr := range triangles {
+			a, b, c := points[tr[0]], points[tr[1]], points[tr[2]]
+			d := 2 * (a.X*(b.Y-c.Y) + b.X*(c.Y-a.Y) + c.X*(a.Y-b.Y))
+			ux := ((a.X*a.X+a.Y*a.Y)*(b.Y-c.Y) + (b.X*b.X+b.Y*b.Y)*(c.Y-a.Y) + (c.X*c.X+c.Y*c.Y)*(a.Y-b.Y)) / d
+			uy := ((a.X*a.X+a.Y*a.Y)*(c.X-b.X) + (b.X*b.X+b.Y*b.Y)*(a.X-c.X) + (c.X*c.X+c.Y*c.Y)*(b.X-a.X)) / d
+			r := math.Hypot(a.X-ux, a.Y-uy)
+			for k, p := range points {
+				if k != tr[0] && k != tr[1] && k != tr[2] && math.Hypot(p.X-ux, p.Y-uy) < r*(1-1e-9) {
+					t.Fatalf("point %v is inside the circumcircle of %v", p, tr)
+				}
+			}
+		}
+	})
+
+	t.Run("wrong input", func(t *testing.T) {
+		tests := map[string][]numericalanalysis.Point2D{
+			"two points": {{X: 0, Y: 0}, {X: 1, Y: 0}},
+			"collinear":  {{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 2}, {X: 3, Y: 3}},
+			"duplicate":  {{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 0, Y: 1}, {X: 1, Y: 0}},
+		}
+		for name, points := range tests {
+			if _, err := numericalanalysis.Delaunay(points); !errors.Is(err, numericalanalysis.ErrWrongInput) {
+				t.Errorf("%s: err = %v, want %v", name, err, numericalanalysis.ErrWrongInput)
+			}
+		}
+	})
+}
+
+func TestDelaunayInterpolation2D(t *testing.T) {
+	plane := func(x, y float64) float64 { return 2*x - 3*y + 1 }
+	f, err := numericalanalysis.DelaunayInterpolation2D(lift(scatter(100, 18), plane))
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+
+	t.Run("reproduces a plane", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(19))
+		for range 1000 {
+			x, y := rng.Float64(), rng.Float64()
+			if got := f(x, y); math.Abs(got-plane(x, y)) > 1e-12 {
+				t.Fatalf("f(%v, %v) = %v, want %v", x, y, got, plane(x, y))
+			}
+		}
+		if got := f(1, 0); math.Abs(got-3) > 1e-12 {
+			t.Errorf("f(1, 0) = %v, want 3", got)
+		}
+	})
+
+	t.Run("NaN outside the convex hull", func(t *testing.T) {
+		if got := f(1.1, 0.5); !math.IsNaN(got) {
+			t.Errorf("f(1.1, 0.5) = %v, want NaN", got)
+		}
+	})
+
+	t.Run("near-collinear hull far from the origin", func(t *testing.T) {
+		// 20 points on a flat arc of a circle of radius r and one point inside, shifted to (1e6, 1e6)
+		for _, r := range []float64{1e4, 1e5, 1e6} {
+			var points []numericalanalysis.Point2D
+			for k := range 20 {
+				x := -10 + 20*float64(k)/19
+				points = append(points, numericalanalysis.Point2D{X: 1e6 + x, Y: 1e6 + math.Sqrt(r*r-x*x) - r})
+			}
+			inside := numericalanalysis.Point2D{X: 1e6, Y: (1e6 + points[0].Y) / 2}
+			points = append(points, inside)
+
+			triangles, err := numericalanalysis.Delaunay(points)
+			if err != nil {
+				t.Fatalf("r = %v: err = %v, want nil", r, err)
+			}
+			if len(triangles) != 20 {
+				t.Errorf("r = %v: %d triangles, want 20", r, len(triangles))
+			}
+
+			// The triangles cover the hull bounded by the arc and its chord
+			area, hull := 0., 0.
+			for _, tr := range triangles {
+				a, b, c := points[tr[0]], points[tr[1]], points[tr[2]]
+				area += ((b.X-a.X)*(c.Y-a.Y) - (c.X-a.X)*(b.Y-a.Y)) / 2
+			}
+			for k := 1; k < 19; k++ {
+				a, b, c := points[0], points[k], points[k+1]
+				hull += ((b.X-a.X)*(c.Y-a.Y) - (c.X-a.X)*(b.Y-a.Y)) / 2
+			}
+			if hull = math.Abs(hull); math.Abs(area-hull) > 1e-9*hull {
+				t.Errorf("r = %v: area = %v, want %v", r, area, hull)
+			}
+
+			// Just inside every edge of the arc
+			f, err := numericalanalysis.DelaunayInterpolation2D(lift(points, plane))
+			if err != nil {
+				t.Fatalf("r = %v: err = %v, want nil", r, err)
+			}
+			for k := range 19 {
+				x := (points[k].X+points[k+1].X)/2*0.99 + inside.X*0.01
+				y := (points[k].Y+points[k+1].Y)/2*0.99 + inside.Y*0.01
+				if got := f(x, y); math.Abs(got-plane(x, y)) > 1e-6 {
+					t.Errorf("r = %v: f(%v, %v) = %v, want %v", r, x, y, got, plane(x, y))
+				}
+			}
+		}
+	})
+}
+
+func TestIDWInterpolation(t *testing.T) {
+	points := [][]float64{{0, 0, 0}, {1, 0, 0}, {0, 1, 0}, {0, 0, 1}, {1, 1, 1}}
+	values := []float64{1, 2, 3, 4, 5}
+	f, err := numericalanalysis.IDWInterpolation(points, values, 2)
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+
+	t.Run("exact at the points and bounded by the values", func(t *testing.T) {
+		for i, p := range points {
+			if got := f(p); got != values[i] {
+				t.Errorf("f(%v) = %v, want %v", p, got, values[i])
+			}
+		}
+		if got := f([]float64{0.5, 0.5, 0.5}); got <= 1 || got >= 5 {
+			t.Errorf("f(center) = %v, want in (1, 5)", got)
+		}
+		// Squared distances from (1/3, 1/3, 1/3): 1/3 to the origin, 2/3 to the unit points, 4/3 to (1, 1, 1)
+		if got, want := f([]float64{1. / 3, 1. / 3, 1. / 3}), (3*1+1.5*(2+3+4)+0.75*5)/(3+3*1.5+0.75); math.Abs(got-want) > 1e-14 {
+			t.Errorf("f(1/3, 1/3, 1/3) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("2D", func(t *testing.T) {
+		g, err := numericalanalysis.IDWInterpolation2D([]numericalanalysis.Point3D{{X: 0, Y: 0, Z: 1}, {X: 2, Y: 0, Z: 3}}, 2)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if got := g(1, 5); math.Abs(got-2) > 1e-15 {
+			t.Errorf("g(1, 5) = %v, want 2", got)
+		}
+	})
+
+	t.Run("wrong input", func(t *testing.T) {
+		tests := map[string]struct {
+			points [][]float64
+			values []float64
+			power  float64
+		}{
+			"no points":          {power: 2},
+			"values mismatch":    {points: points, values: values[:2], power: 2},
+			"ragged points":      {points: [][]float64{{0, 0}, {1}}, values: []float64{1, 2}, power: 2},
+			"non-positive power": {points: points, values: values},
+		}
+		for name, test := range tests {
+			if _, err := numericalanalysis.IDWInterpolation(test.points, test.values, test.power); !errors.Is(err, numericalanalysis.ErrWrongInput) {
+				t.Errorf("%s: err = %v, want %v", name, err, numericalanalysis.ErrWrongInput)
+			}
+		}
+	})
+}
+
+func TestRBFInterpolation(t *testing.T) {
+	f := func(x []float64) float64 { return math.Sin(x[0]) + math.Cos(x[1])*x[2] }
+	rng := rand.New(rand.NewSource(20))
+	points := make([][]float64, 150)
+	values := make([]float64, len(points))
+	for i := range points {
+		points[i] = []float64{rng.Float64(), rng.Float64(), rng.Float64()}
+		values[i] = f(points[i])
+	}
+
+	bases := map[string]struct {
+		basis   numericalanalysis.RadialBasis
+		epsilon float64
+		tol     float64
+	}{
+		"gaussian":     {basis: numericalanalysis.GaussianRBF, epsilon: 2, tol: 1e-2},
+		"multiquadric": {basis: numericalanalysis.MultiquadricRBF, epsilon: 2, tol: 1e-3},
+		"thin-plate":   {basis: numericalanalysis.ThinPlateRBF, tol: 1e-2},
+	}
+	for name, test := range bases {
+		t.Run(name+" - interpolates and approximates", func(t *testing.T) {
+			s, err := numericalanalysis.RBFInterpolation(points, values, test.basis, test.epsilon)
+			if err != nil {
+				t.Fatalf("err = %v, want nil", err)
+			}
+			for i, p := range points {
+				if got := s(p); math.Abs(got-values[i]) > 1e-8 {
+					t.Fatalf("s(%v) = %v, want %v", p, got, values[i])
+				}
+			}
+			for range 100 {
+				x := []float64{0.2 + 0.6*rng.Float64(), 0.2 + 0.6*rng.Float64(), 0.2 + 0.6*rng.Float64()}
+				if got := s(x); math.Abs(got-f(x)) > test.tol {
+					t.Fatalf("s(%v) = %v, want %v", x, got, f(x))
+				}
+			}
+		})
+	}
+
+	t.Run("thin-plate reproduces a plane", func(t *testing.T) {
+		plane := func(x, y float64) float64 { return 2*x - 3*y + 1 }
+		s, err := numericalanalysis.RBFInterpolation2D(lift(scatter(20, 21), plane), numericalanalysis.ThinPlateRBF, 0)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		for _, x := range []float64{-1, 0.3, 0.9, 2} {
+			if got := s(x, 0.5); math.Abs(got-plane(x, 0.5)) > 1e-9 {
+				t.Errorf("s(%v, 0.5) = %v, want %v", x, got, plane(x, 0.5))
+			}
+		}
+	})
+
+	t.Run("wrong input", func(t *testing.T) {
+		if _, err := numericalanalysis.RBFInterpolation(points, values, numericalanalysis.GaussianRBF, 0); !errors.Is(err, numericalanalysis.ErrWrongInput) {
+			t.Errorf("zero epsilon: err = %v, want %v", err, numericalanalysis.ErrWrongInput)
+		}
+		if _, err := numericalanalysis.RBFInterpolation(points, values, 5, 1); !errors.Is(err, numericalanalysis.ErrWrongInput) {
+			t.Errorf("unknown basis: err = %v, want %v", err, numericalanalysis.ErrWrongInput)
+		}
+		few := [][]float64{{0, 0}, {1, 0}}
+		if _, err := numericalanalysis.RBFInterpolation(few, []float64{1, 2}, numericalanalysis.ThinPlateRBF, 0); !errors.Is(err, numericalanalysis.ErrWrongInput) {
+			t.Errorf("thin-plate with too few points: err = %v, want %v", err, numericalanalysis.ErrWrongInput)
+		}
+	})
+}
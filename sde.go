@@ -1,6 +1,7 @@
 package numericalanalysis
 
 import (
+	"context"
 	"math"
 )
 
@@ -16,11 +17,30 @@ func EulerMethod(
 	xChar []float64, // [x1, x2, ...] - Points are always included, regardless of the hBase. The step size is adjusted to ensure that.
 	hBase float64,
 	stop func(x float64, y ...float64) (half bool, stop bool), // half - the step should be halved, stop - hard stop.
+) ([][]Point2D, error) {
+	return EulerMethodContext(context.Background(), 0, f, x0, start, xChar, hBase, stop)
+}
+
+// EulerMethodContext is EulerMethod that stops on ctx cancellation or after maxEvals evaluations of f (0 = unlimited)
+func EulerMethodContext(
+	ctx context.Context,
+	maxEvals int,
+	f FuncSystem,
+	x0 float64,
+	start []float64, // [y1(x0), y2(x0), ...]
+	xChar []float64, // [x1, x2, ...] - Points are always included, regardless of the hBase. The step size is adjusted to ensure that.
+	hBase float64,
+	stop func(x float64, y ...float64) (half bool, stop bool), // half - the step should be halved, stop - hard stop.
 ) ([][]Point2D, error) {
 	// Check input dimensions
 	if len(f) != len(start) {
 		return nil, ErrWrongInput
 	}
+	budget, err := newEvalBudget(ctx, maxEvals)
+	if err != nil {
+		return nil, err
+	}
+	f = budget.funcSystem(f)
 
 	// Initialize result
 	x := make([]float64, 1)
@@ -54,6 +74,11 @@ func EulerMethod(
 			result[i][j] = result[i-1][j] + h*f[j](fromRight, x[i-1], result[i-1]...)
 		}
 
+		// Check cancellation and budget
+		if err := budget.check(); err != nil {
+			return nil, err
+		}
+
 		// Check stop and half contidions
 		half, st := stop(x[i], result[i]...)
 		if st {
@@ -90,11 +115,30 @@ func ModifiedEulerMethod(
 	xChar []float64, // [x1, x2, ...] - Points are always included, regardless of the hBase. The step size is adjusted to ensure that.
 	hBase float64,
 	stop func(x float64, y ...float64) (half bool, stop bool), // half - the step should be halved, stop - hard stop.
+) ([][]Point2D, error) {
+	return ModifiedEulerMethodContext(context.Background(), 0, f, x0, start, xChar, hBase, stop)
+}
+
+// ModifiedEulerMethodContext is ModifiedEulerMethod that stops on ctx cancellation or after maxEvals evaluations of f (0 = unlimited)
+func ModifiedEulerMethodContext(
+	ctx context.Context,
+	maxEvals int,
+	f FuncSystem,
+	x0 float64,
+	start []float64, // [y1(x0), y2(x0), ...]
+	xChar []float64, // [x1, x2, ...] - Points are always included, regardless of the hBase. The step size is adjusted to ensure that.
+	hBase float64,
+	stop func(x float64, y ...float64) (half bool, stop bool), // half - the step should be halved, stop - hard stop.
 ) ([][]Point2D, error) {
 	// Check input dimensions
 	if len(f) != len(start) {
 		return nil, ErrWrongInput
 	}
+	budget, err := newEvalBudget(ctx, maxEvals)
+	if err != nil {
+		return nil, err
+	}
+	f = budget.funcSystem(f)
 
 	// Initialize result
 	x := make([]float64, 1)
@@ -146,6 +190,11 @@ func ModifiedEulerMethod(
 			result[i][j] = result[i-1][j] + h*(k1[j]+k2[j])/2
 		}
 
+		// Check cancellation and budget
+		if err := budget.check(); err != nil {
+			return nil, err
+		}
+
 		// Check stop and half contidions
 		half, st := stop(x[i], result[i]...)
 		if st {
@@ -182,11 +231,30 @@ func RungeKuttaMethod(
 	xChar []float64, // [x1, x2, ...] - Points are always included, regardless of the hBase. The step size is adjusted to ensure that.
 	hBase float64,
 	stop func(x float64, y ...float64) (half bool, stop bool), // half - the step should be halved, stop - hard stop.
+) ([][]Point2D, error) {
+	return RungeKuttaMethodContext(context.Background(), 0, f, x0, start, xChar, hBase, stop)
+}
+
+// RungeKuttaMethodContext is RungeKuttaMethod that stops on ctx cancellation or after maxEvals evaluations of f (0 = unlimited)
+func RungeKuttaMethodContext(
+	ctx context.Context,
+	maxEvals int,
+	f FuncSystem,
+	x0 float64,
+	start []float64, // [y1(x0), y2(x0), ...]
+	xChar []float64, // [x1, x2, ...] - Points are always included, regardless of the hBase. The step size is adjusted to ensure that.
+	hBase float64,
+	stop func(x float64, y ...float64) (half bool, stop bool), // half - the step should be halved, stop - hard stop.
 ) ([][]Point2D, error) {
 	// Check input dimensions
 	if len(f) != len(start) {
 		return nil, ErrWrongInput
 	}
+	budget, err := newEvalBudget(ctx, maxEvals)
+	if err != nil {
+		return nil, err
+	}
+	f = budget.funcSystem(f)
 
 	// Initialize result
 	x := make([]float64, 1)
@@ -262,6 +330,11 @@ func RungeKuttaMethod(
 			result[i][j] = result[i-1][j] + (k1[j]+2*k2[j]+2*k3[j]+k4[j])/6
 		}
 
+		// Check cancellation and budget
+		if err := budget.check(); err != nil {
+			return nil, err
+		}
+
 		// Check stop and half contidions
 		half, st := stop(x[i], result[i]...)
 		if st {
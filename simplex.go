@@ -0,0 +1,215 @@
+package numericalanalysis
+
+import (
+	"math"
+)
+
+// simplex.go
+// Linear programming with the two-phase simplex method
+
+// simplexTol is the tolerance used for pivot selection and feasibility checks
+const simplexTol = 1e-9
+
+// LPResult is the solution of a linear program
+type LPResult struct {
+	X     []float64 // Optimal point
+	Value float64   // Optimal objective value cᵀx
+	Dual  []float64 // Dual values (shadow prices) of the constraints: dValue/db
+}
+
+// SimplexStandard solves the linear program in standard form with the two-phase simplex method
+// min cᵀx subject to A x = b, x >= 0
+// c[n]: objective coefficients
+// A[m][n]: constraint matrix
+// b[m]: right-hand side
+// Returns ErrInfeasible or ErrUnbounded (both wrap ErrNoSolution) if there is no optimal solution.
+func SimplexStandard(c []float64, A Matrix, b []float64) (LPResult, error) {
+	m, n := len(A), len(c)
+
+	// Check input
+	if m == 0 || n == 0 || len(b) != m {
+		return LPResult{}, ErrWrongInput
+	}
+	for i := range A {
+		if len(A[i]) != n {
+			return LPResult{}, ErrWrongInput
+		}
+	}
+
+	// Build tableau [A | I | b] with non-negative right-hand side; columns n..n+m-1 are artificial
+	cols := n + m
+	T := make(Matrix, m)
+	sign := make([]float64, m)
+	basis := make([]int, m)
+	for i := range m {
+		sign[i] = 1
+		if b[i] < 0 {
+			sign[i] = -1
+		}
+		T[i] = make([]float64, cols+1)
+		for j := range n {
+			T[i][j] = sign[i] * A[i][j]
+		}
+		T[i][n+i] = 1
+		T[i][cols] = sign[i] * b[i]
+		basis[i] = n + i
+	}
+
+	// Phase 1: minimize the sum of artificial variables
+	cost := make([]float64, cols)
+	for i := range m {
+		cost[n+i] = 1
+	}
+	if err := simplexIterate(T, basis, cost, cols); err != nil {
+		return LPResult{}, err
+	}
+	infeasibility, scale := 0., 1.
+	for i := range m {
+		infeasibility += cost[basis[i]] * T[i][cols]
+		scale = math.Max(scale, math.Abs(b[i]))
+	}
+	if infeasibility > simplexTol*scale {
+		return LPResult{}, ErrInfeasible
+	}
+
+	// Drive artificial variables out of the basis; rows where it is impossible are redundant
+	for i := range m {
+		if basis[i] < n {
+			continue
+		}
+		for j := range n {
+			if math.Abs(T[i][j]) > simplexTol {
+				simplexPivot(T, basis, i, j)
+				break
+			}
+		}
+	}
+
+	// Phase 2: minimize the original objective, artificial variables may not enter the basis
+	cost = make([]float64, cols)
+	copy(cost, c)
+	if err := simplexIterate(T, basis, cost, n); err != nil {
+		return LPResult{}, err
+	}
+
+	// Extract solution
+	result := LPResult{X: make([]float64, n), Dual: make([]float64, m)}
+	for i := range m {
+		if basis[i] < n {
+			result.X[basis[i]] = T[i][cols]
+		}
+	}
+	for j := range n {
+		result.Value += c[j] * result.X[j]
+	}
+
+	// Dual values y = c_Bᵀ B⁻¹, where B⁻¹ is stored in the artificial columns
+	for i := range m {
+		for k := range m {
+			result.Dual[i] += cost[basis[k]] * T[k][n+i]
+		}
+		result.Dual[i] *= sign[i]
+	}
+
+	return result, nil
+}
+
+// SimplexInequality solves the linear program in inequality form with the two-phase simplex method
+// min cᵀx subject to A x <= b, x >= 0
+// c[n]: objective coefficients
+// A[m][n]: constraint matrix
+// b[m]: right-hand side
+// Returns ErrInfeasible or ErrUnbounded (both wrap ErrNoSolution) if there is no optimal solution.
+func SimplexInequality(c []float64, A Matrix, b []float64) (LPResult, error) {
+	m, n := len(A), len(c)
+
+	// Check input
+	if m == 0 || n == 0 || len(b) != m {
+		return LPResult{}, ErrWrongInput
+	}
+	for i := range A {
+		if len(A[i]) != n {
+			return LPResult{}, ErrWrongInput
+		}
+	}
+
+	// Add slack variables: [A | I] (x, s) = b
+	cs := make([]float64, n+m)
+	copy(cs, c)
+	As := make(Matrix, m)
+	for i := range m {
+		As[i] = make([]float64, n+m)
+		copy(As[i], A[i])
+		As[i][n+i] = 1
+	}
+
+	result, err := SimplexStandard(cs, As, b)
+	if err != nil {
+		return LPResult{}, err
+	}
+	result.X = result.X[:n]
+
+	return result, nil
+}
+
+// simplexIterate runs simplex iterations on the tableau until the objective can not be improved.
+// Only the first `allowed` columns may enter the basis. Bland's rule is used to prevent cycling.
+func simplexIterate(T Matrix, basis []int, cost []float64, allowed int) error {
+	m := len(T)
+	rhs := len(T[0]) - 1
+
+	for {
+		// Find entering column: first one with a negative reduced cost
+		enter := -1
+		for j := range allowed {
+			r := cost[j]
+			for i := range m {
+				r -= cost[basis[i]] * T[i][j]
+			}
+			if r < -simplexTol {
+				enter = j
+				break
+			}
+		}
+		if enter < 0 {
+			return nil
+		}
+
+		// Ratio test, ties are broken by the smallest basis index
+		leave := -1
+		minRatio := math.Inf(1)
+		for i := range m {
+			if T[i][enter] <= simplexTol {
+				continue
+			}
+			ratio := T[i][rhs] / T[i][enter]
+			if ratio < minRatio-simplexTol || (ratio <= minRatio+simplexTol && leave >= 0 && basis[i] < basis[leave]) {
+				minRatio = ratio
+				leave = i
+			}
+		}
+		if leave < 0 {
+			return ErrUnbounded
+		}
+
+		simplexPivot(T, basis, leave, enter)
+	}
+}
+
+// simplexPivot makes column col basic in row row
+func simplexPivot(T Matrix, basis []int, row, col int) {
+	p := T[row][col]
+	for j := range T[row] {
+		T[row][j] /= p
+	}
+	for i := range T {
+		if i == row || T[i][col] == 0 {
+			continue
+		}
+		k := T[i][col]
+		for j := range T[i] {
+			T[i][j] -= k * T[row][j]
+		}
+	}
+	basis[row] = col
+}
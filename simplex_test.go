@@ -0,0 +1,170 @@
+package numericalanalysis_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	numericalanalysis "github.com/Russia9/numerical-analysis"
+)
+
+func TestSimplexStandard(t *testing.T) {
+	t.Run("input validation - mismatched right-hand side", func(t *testing.T) {
+		_, err := numericalanalysis.SimplexStandard([]float64{1, 1}, numericalanalysis.Matrix{{1, 1}}, []float64{1, 2})
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+
+	t.Run("input validation - ragged matrix", func(t *testing.T) {
+		_, err := numericalanalysis.SimplexStandard([]float64{1, 1}, numericalanalysis.Matrix{{1, 1}, {1}}, []float64{1, 2})
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+
+	t.Run("unique feasible point", func(t *testing.T) {
+		// min x1 + x2 s.t. x1 + 2x2 = 4, 3x1 + x2 = 7, solution (2, 1), duals (0.4, 0.2)
+		c := []float64{1, 1}
+		A := numericalanalysis.Matrix{{1, 2}, {3, 1}}
+		b := []float64{4, 7}
+
+		result, err := numericalanalysis.SimplexStandard(c, A, b)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		assertSlice(t, "X", result.X, []float64{2, 1}, 1e-9)
+		assertSlice(t, "Dual", result.Dual, []float64{0.4, 0.2}, 1e-9)
+		if math.Abs(result.Value-3) > 1e-9 {
+			t.Errorf("Value = %v, want 3", result.Value)
+		}
+	})
+
+	t.Run("negative right-hand side", func(t *testing.T) {
+		// min 2x1 + x2 + x3 s.t. -x1 - x2 - x3 = -2, x1 - x3 = 0, solution x2 = 2, duals (-1, -1)
+		c := []float64{2, 1, 1}
+		A := numericalanalysis.Matrix{{-1, -1, -1}, {1, 0, -1}}
+		b := []float64{-2, 0}
+
+		result, err := numericalanalysis.SimplexStandard(c, A, b)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(result.Value-2) > 1e-9 {
+			t.Errorf("Value = %v, want 2", result.Value)
+		}
+		assertSlice(t, "X", result.X, []float64{0, 2, 0}, 1e-9)
+		assertSlice(t, "Dual", result.Dual, []float64{-1, 1}, 1e-9)
+	})
+
+	t.Run("redundant constraint", func(t *testing.T) {
+		// min -x1 - x2 s.t. x1 + x2 + x3 = 1, 2x1 + 2x2 + 2x3 = 2
+		c := []float64{-1, -1, 0}
+		A := numericalanalysis.Matrix{{1, 1, 1}, {2, 2, 2}}
+		b := []float64{1, 2}
+
+		result, err := numericalanalysis.SimplexStandard(c, A, b)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(result.Value+1) > 1e-9 {
+			t.Errorf("Value = %v, want -1", result.Value)
+		}
+	})
+
+	t.Run("infeasible", func(t *testing.T) {
+		// x1 + x2 = 1, x1 + x2 = 2
+		c := []float64{1, 1}
+		A := numericalanalysis.Matrix{{1, 1}, {1, 1}}
+		b := []float64{1, 2}
+
+		_, err := numericalanalysis.SimplexStandard(c, A, b)
+		if err != numericalanalysis.ErrInfeasible {
+			t.Errorf("err = %v, want ErrInfeasible", err)
+		}
+		if !errors.Is(err, numericalanalysis.ErrNoSolution) {
+			t.Errorf("errors.Is(%v, ErrNoSolution) = false, want true", err)
+		}
+	})
+}
+
+func TestSimplexInequality(t *testing.T) {
+	t.Run("textbook production problem", func(t *testing.T) {
+		// max 3x + 5y s.t. x <= 4, 2y <= 12, 3x + 2y <= 18, solution (2, 6), value 36
+		c := []float64{-3, -5}
+		A := numericalanalysis.Matrix{{1, 0}, {0, 2}, {3, 2}}
+		b := []float64{4, 12, 18}
+
+		result, err := numericalanalysis.SimplexInequality(c, A, b)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		assertSlice(t, "X", result.X, []float64{2, 6}, 1e-9)
+		assertSlice(t, "Dual", result.Dual, []float64{0, -1.5, -1}, 1e-9)
+		if math.Abs(result.Value+36) > 1e-9 {
+			t.Errorf("Value = %v, want -36", result.Value)
+		}
+	})
+
+	t.Run("degenerate vertex", func(t *testing.T) {
+		// max x + y s.t. x <= 1, y <= 1, x + y <= 2, x - y <= 0
+		c := []float64{-1, -1}
+		A := numericalanalysis.Matrix{{1, 0}, {0, 1}, {1, 1}, {1, -1}}
+		b := []float64{1, 1, 2, 0}
+
+		result, err := numericalanalysis.SimplexInequality(c, A, b)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		assertSlice(t, "X", result.X, []float64{1, 1}, 1e-9)
+	})
+
+	t.Run("lower bound via negative right-hand side", func(t *testing.T) {
+		// min x + y s.t. x + y >= 3 (-x - y <= -3), x <= 1
+		c := []float64{1, 1}
+		A := numericalanalysis.Matrix{{-1, -1}, {1, 0}}
+		b := []float64{-3, 1}
+
+		result, err := numericalanalysis.SimplexInequality(c, A, b)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(result.Value-3) > 1e-9 {
+			t.Errorf("Value = %v, want 3", result.Value)
+		}
+		assertSlice(t, "Dual", result.Dual, []float64{-1, 0}, 1e-9)
+	})
+
+	t.Run("infeasible", func(t *testing.T) {
+		// x + y <= -1 with x, y >= 0
+		_, err := numericalanalysis.SimplexInequality([]float64{1, 1}, numericalanalysis.Matrix{{1, 1}}, []float64{-1})
+		if err != numericalanalysis.ErrInfeasible {
+			t.Errorf("err = %v, want ErrInfeasible", err)
+		}
+	})
+
+	t.Run("unbounded", func(t *testing.T) {
+		// min -x s.t. -x + y <= 1
+		_, err := numericalanalysis.SimplexInequality([]float64{-1, 0}, numericalanalysis.Matrix{{-1, 1}}, []float64{1})
+		if err != numericalanalysis.ErrUnbounded {
+			t.Errorf("err = %v, want ErrUnbounded", err)
+		}
+		if !errors.Is(err, numericalanalysis.ErrNoSolution) {
+			t.Errorf("errors.Is(%v, ErrNoSolution) = false, want true", err)
+		}
+	})
+}
+
+func assertSlice(t *testing.T, name string, got, want []float64, tol float64) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("len(%s) = %v, want %v", name, len(got), len(want))
+		return
+	}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > tol {
+			t.Errorf("%s = %v, want ~%v", name, got, want)
+			return
+		}
+	}
+}
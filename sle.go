@@ -1,5 +1,7 @@
 package numericalanalysis
 
+import "math"
+
 // sle.go
 // Systems of linear equations solvers
 
@@ -39,3 +41,106 @@ func Cramer(matrix Matrix, free []float64) ([]float64, error) {
 
 	return result, nil
 }
+
+// GaussianElimination solves a system of linear equations by Gaussian elimination with partial pivoting
+// matrix[n][n]: coefficients
+// free[n]: free vector
+func GaussianElimination(matrix Matrix, free []float64) ([]float64, error) {
+	n := len(matrix)
+
+	// Check input
+	if n == 0 || len(free) != n {
+		return nil, ErrWrongInput
+	}
+	for i := range matrix {
+		if len(matrix[i]) != n {
+			return nil, ErrWrongInput
+		}
+	}
+
+	// Augmented matrix
+	a := make(Matrix, n)
+	for i := range matrix {
+		a[i] = make([]float64, n+1)
+		copy(a[i], matrix[i])
+		a[i][n] = free[i]
+	}
+
+	// Forward elimination
+	for k := range n {
+		// Choose the pivot with the largest absolute value
+		p := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(a[i][k]) > math.Abs(a[p][k]) {
+				p = i
+			}
+		}
+		if a[p][k] == 0 {
+			return nil, ErrSingularMatrix
+		}
+		a[k], a[p] = a[p], a[k]
+
+		for i := k + 1; i < n; i++ {
+			m := a[i][k] / a[k][k]
+			for j := k; j <= n; j++ {
+				a[i][j] -= m * a[k][j]
+			}
+		}
+	}
+
+	// Back substitution
+	x := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := a[i][n]
+		for j := i + 1; j < n; j++ {
+			sum -= a[i][j] * x[j]
+		}
+		x[i] = sum / a[i][i]
+	}
+
+	return x, nil
+}
+
+// Tridiagonal solves a tridiagonal system of linear equations with the Thomas algorithm
+// sub[n-1]: subdiagonal, sub[i] is the coefficient of x[i] in equation i+1
+// diag[n]: diagonal
+// super[n-1]: superdiagonal, super[i] is the coefficient of x[i+1] in equation i
+// free[n]: free vector
+func Tridiagonal(sub, diag, super, free []float64) ([]float64, error) {
+	n := len(diag)
+
+	// Check input
+	if n == 0 || len(sub) != n-1 || len(super) != n-1 || len(free) != n {
+		return nil, ErrWrongInput
+	}
+
+	// Forward sweep
+	c := make([]float64, n)
+	d := make([]float64, n)
+	for i := range n {
+		m := diag[i]
+		if i > 0 {
+			m -= sub[i-1] * c[i-1]
+		}
+		if m == 0 {
+			return nil, ErrSingularMatrix
+		}
+		if i < n-1 {
+			c[i] = super[i] / m
+		}
+		d[i] = free[i]
+		if i > 0 {
+			d[i] -= sub[i-1] * d[i-1]
+		}
+		d[i] /= m
+	}
+
+	// Back substitution
+	x := make([]float64, n)
+	x[n-1] = d[n-1]
+	for i := n - 2; i >= 0; i-- {
+		x[i] = d[i] - c[i]*x[i+1]
+	}
+
+	return x, nil
+}
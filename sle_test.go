@@ -27,3 +27,89 @@ func TestCramer(t *testing.T) {
 		}
 	})
 }
+
+func TestTridiagonal(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		// 2x0 + x1 = 3, x0 + 3x1 + x2 = 7, x1 + 2x2 = 7
+		sub := []float64{1, 1}
+		diag := []float64{2, 3, 2}
+		super := []float64{1, 1}
+		free := []float64{3, 7, 7}
+
+		result, err := numericalanalysis.Tridiagonal(sub, diag, super, free)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertSlice(t, "result", result, []float64{1, 1, 3}, 1e-14)
+	})
+
+	t.Run("single equation", func(t *testing.T) {
+		result, err := numericalanalysis.Tridiagonal(nil, []float64{4}, nil, []float64{2})
+		if err != nil || result[0] != 0.5 {
+			t.Errorf("result = %v, err = %v, want [0.5]", result, err)
+		}
+	})
+
+	t.Run("zero pivot", func(t *testing.T) {
+		_, err := numericalanalysis.Tridiagonal([]float64{1}, []float64{0, 1}, []float64{1}, []float64{1, 1})
+		if err != numericalanalysis.ErrSingularMatrix {
+			t.Errorf("err = %v, want ErrSingularMatrix", err)
+		}
+	})
+
+	t.Run("input validation", func(t *testing.T) {
+		_, err := numericalanalysis.Tridiagonal([]float64{1}, []float64{1, 1}, []float64{1, 1}, []float64{1, 1})
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+}
+
+func TestGaussianElimination(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		matrix := numericalanalysis.Matrix{
+			{2, 3},
+			{1, 1},
+		}
+		free := []float64{1, -1}
+
+		result, err := numericalanalysis.GaussianElimination(matrix, free)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertSlice(t, "result", result, []float64{-4, 3}, 1e-14)
+	})
+
+	t.Run("needs pivoting", func(t *testing.T) {
+		matrix := numericalanalysis.Matrix{
+			{0, 1, 1},
+			{1, 0, 1},
+			{1, 1, 0},
+		}
+		free := []float64{5, 4, 3}
+
+		result, err := numericalanalysis.GaussianElimination(matrix, free)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertSlice(t, "result", result, []float64{1, 2, 3}, 1e-14)
+	})
+
+	t.Run("singular matrix", func(t *testing.T) {
+		matrix := numericalanalysis.Matrix{
+			{1, 2},
+			{2, 4},
+		}
+		_, err := numericalanalysis.GaussianElimination(matrix, []float64{1, 2})
+		if err != numericalanalysis.ErrSingularMatrix {
+			t.Errorf("err = %v, want ErrSingularMatrix", err)
+		}
+	})
+
+	t.Run("input validation", func(t *testing.T) {
+		_, err := numericalanalysis.GaussianElimination(numericalanalysis.Matrix{{1, 2}}, []float64{1})
+		if err != numericalanalysis.ErrWrongInput {
+			t.Errorf("err = %v, want ErrWrongInput", err)
+		}
+	})
+}
@@ -0,0 +1,214 @@
+package numericalanalysis
+
+import "sort"
+
+// spline.go
+// Cubic spline interpolation
+
+// SplineBoundary is a boundary condition of a cubic spline
+type SplineBoundary int
+
+const (
+	NaturalSpline  SplineBoundary = iota // Zero second derivative at both ends
+	ClampedSpline                        // Given first derivatives at both ends
+	NotAKnotSpline                       // Continuous third derivative at the second and the next to last points
+)
+
+// CubicSpline is a C² piecewise cubic interpolant.
+// Outside the points it continues the cubic of the first or the last interval.
+type CubicSpline struct {
+	x, y []float64 // Sorted points
+	m    []float64 // Second derivatives at the points
+	cum  []float64 // cum[i] = ∫[x_0, x_i] S
+}
+
+// NewCubicSpline builds a cubic spline through the points. The points do not have to be sorted, but their X must be distinct.
+// d0, dn: first derivatives at the first and the last point for ClampedSpline, ignored otherwise
+func NewCubicSpline(points []Point2D, boundary SplineBoundary, d0, dn float64) (*CubicSpline, error) {
+	x, y, err := sortedPoints(points)
+	if err != nil {
+		return nil, err
+	}
+	s := &CubicSpline{x: x, y: y}
+	n := len(x)
+	s.m, err = splineMoments(x, y, boundary, d0, dn)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cum = make([]float64, n)
+	for i := 1; i < n; i++ {
+		s.cum[i] = s.cum[i-1] + s.primitive(i-1, s.x[i])
+	}
+
+	return s, nil
+}
+
+// Eval returns the value of the spline at x
+func (s *CubicSpline) Eval(x float64) float64 {
+	i, h, a, b := s.interval(x)
+	A, B := s.linearTerms(i, h)
+	return (s.m[i]*a*a*a+s.m[i+1]*b*b*b)/(6*h) + A*a + B*b
+}
+
+// Derivative returns the first derivative of the spline at x
+func (s *CubicSpline) Derivative(x float64) float64 {
+	i, h, a, b := s.interval(x)
+	A, B := s.linearTerms(i, h)
+	return (s.m[i+1]*b*b-s.m[i]*a*a)/(2*h) - A + B
+}
+
+// SecondDerivative returns the second derivative of the spline at x
+func (s *CubicSpline) SecondDerivative(x float64) float64 {
+	i, h, a, b := s.interval(x)
+	return (s.m[i]*a + s.m[i+1]*b) / h
+}
+
+// Integral returns the exact integral of the spline over [a, b]
+func (s *CubicSpline) Integral(a, b float64) float64 {
+	return s.antiderivative(b) - s.antiderivative(a)
+}
+
+// EvalErr returns the value of the spline at x, or ErrOutOfDomain outside the points
+func (s *CubicSpline) EvalErr(x float64) (float64, error) {
+	return evalInDomain(s, x)
+}
+
+// Domain returns the smallest and the largest X of the points
+func (s *CubicSpline) Domain() (float64, float64) {
+	return s.x[0], s.x[len(s.x)-1]
+}
+
+// antiderivative returns ∫[x_0, x] S
+func (s *CubicSpline) antiderivative(x float64) float64 {
+	i, _, _, _ := s.interval(x)
+	return s.cum[i] + s.primitive(i, x)
+}
+
+// interval returns the index of the interval for x, its length and the distances x_{i+1} - x and x - x_i
+func (s *CubicSpline) interval(x float64) (int, float64, float64, float64) {
+	i := findInterval(s.x, x)
+	return i, s.x[i+1] - s.x[i], s.x[i+1] - x, x - s.x[i]
+}
+
+// linearTerms returns the coefficients of (x_{i+1} - x) and (x - x_i) in the spline on interval i
+func (s *CubicSpline) linearTerms(i int, h float64) (float64, float64) {
+	return s.y[i]/h - s.m[i]*h/6, s.y[i+1]/h - s.m[i+1]*h/6
+}
+
+// primitive returns ∫[x_i, x] of the cubic of interval i
+func (s *CubicSpline) primitive(i int, x float64) float64 {
+	h := s.x[i+1] - s.x[i]
+	A, B := s.linearTerms(i, h)
+	F := func(a, b float64) float64 {
+		return (s.m[i+1]*b*b*b*b-s.m[i]*a*a*a*a)/(24*h) + (B*b*b-A*a*a)/2
+	}
+	return F(s.x[i+1]-x, x-s.x[i]) - F(h, 0)
+}
+
+// splineMoments returns the second derivatives at the sorted points x of the cubic spline through y with the boundary condition
+func splineMoments(x, y []float64, boundary SplineBoundary, d0, dn float64) ([]float64, error) {
+	s := &CubicSpline{x: x, y: y}
+	switch boundary {
+	case NaturalSpline:
+		return s.naturalMoments()
+	case ClampedSpline:
+		return s.clampedMoments(d0, dn)
+	case NotAKnotSpline:
+		return s.notAKnotMoments()
+	}
+	return nil, ErrWrongInput
+}
+
+// momentSystem returns the tridiagonal equations h_{i-1} M_{i-1} + 2(h_{i-1} + h_i) M_i + h_i M_{i+1} = r_i
+// for the inner points i = 1..n-2, padded with empty first and last rows
+func (s *CubicSpline) momentSystem() (sub, diag, super, free []float64) {
+	n := len(s.x)
+	sub, diag, super, free = make([]float64, n-1), make([]float64, n), make([]float64, n-1), make([]float64, n)
+	for i := 1; i < n-1; i++ {
+		h0, h1 := s.x[i]-s.x[i-1], s.x[i+1]-s.x[i]
+		sub[i-1] = h0
+		diag[i] = 2 * (h0 + h1)
+		super[i] = h1
+		free[i] = 6 * ((s.y[i+1]-s.y[i])/h1 - (s.y[i]-s.y[i-1])/h0)
+	}
+	return sub, diag, super, free
+}
+
+// naturalMoments solves for the second derivatives with M_0 = M_{n-1} = 0
+func (s *CubicSpline) naturalMoments() ([]float64, error) {
+	sub, diag, super, free := s.momentSystem()
+	n := len(s.x)
+	diag[0], diag[n-1] = 1, 1
+	super[0], sub[n-2] = 0, 0
+	return Tridiagonal(sub, diag, super, free)
+}
+
+// clampedMoments solves for the second derivatives with S'(x_0) = d0 and S'(x_{n-1}) = dn
+func (s *CubicSpline) clampedMoments(d0, dn float64) ([]float64, error) {
+	sub, diag, super, free := s.momentSystem()
+	n := len(s.x)
+	h0, hn := s.x[1]-s.x[0], s.x[n-1]-s.x[n-2]
+	diag[0], super[0], free[0] = 2*h0, h0, 6*((s.y[1]-s.y[0])/h0-d0)
+	sub[n-2], diag[n-1], free[n-1] = hn, 2*hn, 6*(dn-(s.y[n-1]-s.y[n-2])/hn)
+	return Tridiagonal(sub, diag, super, free)
+}
+
+// notAKnotMoments solves for the second derivatives with a continuous third derivative at x_1 and x_{n-2}
+func (s *CubicSpline) notAKnotMoments() ([]float64, error) {
+	n := len(s.x)
+	switch n {
+	case 2: // Line
+		return make([]float64, 2), nil
+	case 3: // Parabola through the three points
+		d := 2 * ((s.y[2]-s.y[1])/(s.x[2]-s.x[1]) - (s.y[1]-s.y[0])/(s.x[1]-s.x[0])) / (s.x[2] - s.x[0])
+		return []float64{d, d, d}, nil
+	}
+
+	// h_1 M_0 - (h_0 + h_1) M_1 + h_0 M_2 = 0 eliminates M_0 from the first inner equation, likewise at the end
+	sub, diag, super, free := s.momentSystem()
+	h0, h1 := s.x[1]-s.x[0], s.x[2]-s.x[1]
+	hm, hn := s.x[n-2]-s.x[n-3], s.x[n-1]-s.x[n-2]
+	diag[1] += h0 * (h0 + h1) / h1
+	super[1] -= h0 * h0 / h1
+	diag[n-2] += hn * (hm + hn) / hm
+	sub[n-3] -= hn * hn / hm
+
+	inner, err := Tridiagonal(sub[1:n-2], diag[1:n-1], super[1:n-2], free[1:n-1])
+	if err != nil {
+		return nil, err
+	}
+	m := make([]float64, n)
+	copy(m[1:], inner)
+	m[0] = ((h0+h1)*m[1] - h0*m[2]) / h1
+	m[n-1] = ((hm+hn)*m[n-2] - hn*m[n-3]) / hm
+	return m, nil
+}
+
+// sortedPoints checks that there are at least two points with distinct X and returns their coordinates sorted by X.
+// The points are not modified.
+func sortedPoints(points []Point2D) ([]float64, []float64, error) {
+	// Check input
+	if len(points) < 2 {
+		return nil, nil, ErrWrongInput
+	}
+
+	sorted := append([]Point2D(nil), points...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].X < sorted[j].X })
+	x := make([]float64, len(sorted))
+	y := make([]float64, len(sorted))
+	for i, p := range sorted {
+		x[i], y[i] = p.X, p.Y
+		if i > 0 && x[i] == x[i-1] {
+			return nil, nil, ErrWrongInput
+		}
+	}
+	return x, y, nil
+}
+
+// findInterval returns the index i of the interval [x_i, x_{i+1}] containing v, or the first or the last interval
+// if v is outside the sorted nodes x
+func findInterval(x []float64, v float64) int {
+	i := sort.SearchFloat64s(x, v) - 1
+	return min(max(i, 0), len(x)-2)
+}
@@ -0,0 +1,118 @@
+package numericalanalysis_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	numericalanalysis "github.com/Russia9/numerical-analysis"
+)
+
+func TestCubicSpline(t *testing.T) {
+	cubic := func(x float64) float64 { return x*x*x - 2*x*x + 3*x - 1 }
+	dcubic := func(x float64) float64 { return 3*x*x - 4*x + 3 }
+	d2cubic := func(x float64) float64 { return 6*x - 4 }
+	// ∫ cubic = x⁴/4 - 2x³/3 + 3x²/2 - x
+	icubic := func(x float64) float64 { return x*x*x*x/4 - 2*x*x*x/3 + 3*x*x/2 - x }
+
+	t.Run("clamped and not-a-knot reproduce a cubic", func(t *testing.T) {
+		points := samples(cubic, -1, 2, 9, 3)
+		for name, boundary := range map[string]numericalanalysis.SplineBoundary{
+			"clamped":    numericalanalysis.ClampedSpline,
+			"not-a-knot": numericalanalysis.NotAKnotSpline,
+		} {
+			s, err := numericalanalysis.NewCubicSpline(points, boundary, dcubic(-1), dcubic(2))
+			if err != nil {
+				t.Fatalf("%s: err = %v, want nil", name, err)
+			}
+			for _, x := range []float64{-1, -0.7, 0, 0.33, 1.5, 2} {
+				if got := s.Eval(x); math.Abs(got-cubic(x)) > 1e-12 {
+					t.Errorf("%s: Eval(%v) = %v, want %v", name, x, got, cubic(x))
+				}
+				if got := s.Derivative(x); math.Abs(got-dcubic(x)) > 1e-10 {
+					t.Errorf("%s: Derivative(%v) = %v, want %v", name, x, got, dcubic(x))
+				}
+				if got := s.SecondDerivative(x); math.Abs(got-d2cubic(x)) > 1e-9 {
+					t.Errorf("%s: SecondDerivative(%v) = %v, want %v", name, x, got, d2cubic(x))
+				}
+			}
+			if got, want := s.Integral(-0.5, 1.7), icubic(1.7)-icubic(-0.5); math.Abs(got-want) > 1e-12 {
+				t.Errorf("%s: Integral = %v, want %v", name, got, want)
+			}
+		}
+	})
+
+	t.Run("natural spline has zero curvature at the ends", func(t *testing.T) {
+		s, err := numericalanalysis.NewCubicSpline(samples(math.Exp, 0, 1, 10, 4), numericalanalysis.NaturalSpline, 0, 0)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if math.Abs(s.SecondDerivative(0)) > 1e-12 || math.Abs(s.SecondDerivative(1)) > 1e-12 {
+			t.Errorf("S''(0) = %v, S''(1) = %v, want 0", s.SecondDerivative(0), s.SecondDerivative(1))
+		}
+	})
+
+	t.Run("interpolates and converges", func(t *testing.T) {
+		f := func(x float64) float64 { return 1 / (1 + 25*x*x) }
+		points := make([]numericalanalysis.Point2D, 41)
+		for i := range points {
+			x := -1 + float64(i)/20
+			points[i] = numericalanalysis.Point2D{X: x, Y: f(x)}
+		}
+		s, err := numericalanalysis.NewCubicSpline(points, numericalanalysis.NotAKnotSpline, 0, 0)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		for _, p := range points {
+			if got := s.Eval(p.X); math.Abs(got-p.Y) > 1e-14 {
+				t.Errorf("Eval(%v) = %v, want %v", p.X, got, p.Y)
+			}
+		}
+		for x := -1.; x <= 1; x += 0.013 {
+			if got := s.Eval(x); math.Abs(got-f(x)) > 1e-3 {
+				t.Errorf("Eval(%v) = %v, want %v", x, got, f(x))
+			}
+		}
+		// ∫[-1,1] 1/(1+25x²) = 2/5·atan 5
+		if got, want := s.Integral(-1, 1), 0.4*math.Atan(5); math.Abs(got-want) > 1e-4 {
+			t.Errorf("Integral = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("not-a-knot with three points is a parabola", func(t *testing.T) {
+		sq := func(x float64) float64 { return x * x }
+		s, err := numericalanalysis.NewCubicSpline(samples(sq, 0, 3, 3, 5), numericalanalysis.NotAKnotSpline, 0, 0)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if got := s.Eval(2.5); math.Abs(got-6.25) > 1e-12 {
+			t.Errorf("Eval(2.5) = %v, want 6.25", got)
+		}
+	})
+
+	t.Run("does not modify the points", func(t *testing.T) {
+		points := []numericalanalysis.Point2D{{X: 2, Y: 1}, {X: 0, Y: 0}, {X: 1, Y: 3}}
+		if _, err := numericalanalysis.NewCubicSpline(points, numericalanalysis.NaturalSpline, 0, 0); err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if points[0].X != 2 || points[1].X != 0 {
+			t.Errorf("points were reordered: %v", points)
+		}
+	})
+
+	t.Run("wrong input", func(t *testing.T) {
+		tests := map[string]struct {
+			points   []numericalanalysis.Point2D
+			boundary numericalanalysis.SplineBoundary
+		}{
+			"one point":        {points: []numericalanalysis.Point2D{{X: 0, Y: 1}}},
+			"duplicate x":      {points: []numericalanalysis.Point2D{{X: 0, Y: 1}, {X: 1, Y: 2}, {X: 0, Y: 3}}},
+			"unknown boundary": {points: []numericalanalysis.Point2D{{X: 0, Y: 1}, {X: 1, Y: 2}}, boundary: 7},
+		}
+		for name, test := range tests {
+			if _, err := numericalanalysis.NewCubicSpline(test.points, test.boundary, 0, 0); !errors.Is(err, numericalanalysis.ErrWrongInput) {
+				t.Errorf("%s: err = %v, want %v", name, err, numericalanalysis.ErrWrongInput)
+			}
+		}
+	})
+}
@@ -0,0 +1,104 @@
+package numericalanalysis
+
+import "sort"
+
+// tabulated.go
+// Integration of tabulated data on irregular grids
+
+// TabulatedRule is a rule for integrating tabulated data
+type TabulatedRule int
+
+const (
+	TabulatedTrapezoid TabulatedRule = iota // Linear interpolation between the points
+	TabulatedSimpson                        // Quadratic through every pair of intervals, the last interval of an odd count uses the last three points
+	TabulatedSpline                         // Natural cubic spline through the points
+)
+
+// IntegralPoints calculates the integral of tabulated data over [min X, max X].
+// The points do not have to be sorted or equally spaced, but their X must be distinct.
+func IntegralPoints(points []Point2D, rule TabulatedRule) (float64, error) {
+	_, parts, err := tabulatedIntervals(points, rule)
+	if err != nil {
+		return 0, err
+	}
+
+	res := 0.
+	for _, p := range parts {
+		res += p
+	}
+	return res, nil
+}
+
+// CumulativeIntegralPoints calculates the running integral of tabulated data:
+// the result has a point for every X with Y = ∫[min X, X], sorted by X
+func CumulativeIntegralPoints(points []Point2D, rule TabulatedRule) ([]Point2D, error) {
+	sorted, parts, err := tabulatedIntervals(points, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]Point2D, len(sorted))
+	res[0] = Point2D{X: sorted[0].X}
+	for i, p := range parts {
+		res[i+1] = Point2D{X: sorted[i+1].X, Y: res[i].Y + p}
+	}
+	return res, nil
+}
+
+// tabulatedIntervals sorts a copy of the points and integrates the rule over every interval between them
+func tabulatedIntervals(points []Point2D, rule TabulatedRule) ([]Point2D, []float64, error) {
+	// Check input
+	if len(points) < 2 {
+		return nil, nil, ErrWrongInput
+	}
+	sorted := append([]Point2D(nil), points...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].X < sorted[j].X })
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].X == sorted[i-1].X {
+			return nil, nil, ErrWrongInput
+		}
+	}
+
+	n := len(sorted) - 1
+	parts := make([]float64, n)
+	switch rule {
+	case TabulatedTrapezoid:
+		for i := range n {
+			parts[i] = (sorted[i+1].X - sorted[i].X) * (sorted[i].Y + sorted[i+1].Y) / 2
+		}
+	case TabulatedSimpson:
+		if n == 1 {
+			return tabulatedIntervals(sorted, TabulatedTrapezoid)
+		}
+		for i := range n {
+			// First point of the three the quadratic passes through
+			k := i - i%2
+			if k+2 > n {
+				k = n - 2
+			}
+			p0, p1, p2 := sorted[k], sorted[k+1], sorted[k+2]
+			parts[i] = quadraticIntegral(p0, p1, p2, sorted[i+1].X-p0.X) - quadraticIntegral(p0, p1, p2, sorted[i].X-p0.X)
+		}
+	case TabulatedSpline:
+		spline, err := NewCubicSpline(sorted, NaturalSpline, 0, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		for i := range n {
+			parts[i] = spline.Integral(sorted[i].X, sorted[i+1].X)
+		}
+	default:
+		return nil, nil, ErrWrongInput
+	}
+
+	return sorted, parts, nil
+}
+
+// quadraticIntegral integrates the quadratic through p0, p1 and p2 over [p0.X, p0.X + L]
+func quadraticIntegral(p0, p1, p2 Point2D, L float64) float64 {
+	// Newton form p(x) = y0 + d1 (x - x0) + d2 (x - x0)(x - x1)
+	h0 := p1.X - p0.X
+	d1 := (p1.Y - p0.Y) / h0
+	d2 := ((p2.Y-p1.Y)/(p2.X-p1.X) - d1) / (p2.X - p0.X)
+	return p0.Y*L + d1*L*L/2 + d2*(L*L*L/3-h0*L*L/2)
+}
@@ -0,0 +1,121 @@
+package numericalanalysis_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	numericalanalysis "github.com/Russia9/numerical-analysis"
+)
+
+// samples tabulates f at irregularly spaced points in [a, b] including the endpoints, in shuffled order
+func samples(f numericalanalysis.Func1D, a, b float64, n int, seed int64) []numericalanalysis.Point2D {
+	rng := rand.New(rand.NewSource(seed))
+	points := []numericalanalysis.Point2D{{X: a, Y: f(a)}, {X: b, Y: f(b)}}
+	for range n - 2 {
+		x := a + rng.Float64()*(b-a)
+		points = append(points, numericalanalysis.Point2D{X: x, Y: f(x)})
+	}
+	rng.Shuffle(len(points), func(i, j int) { points[i], points[j] = points[j], points[i] })
+	return points
+}
+
+func TestIntegralPoints(t *testing.T) {
+	rules := map[string]struct {
+		rule numericalanalysis.TabulatedRule
+		tol  float64
+	}{
+		"trapezoid": {rule: numericalanalysis.TabulatedTrapezoid, tol: 1e-3},
+		"simpson":   {rule: numericalanalysis.TabulatedSimpson, tol: 1e-5},
+		"spline":    {rule: numericalanalysis.TabulatedSpline, tol: 1e-5},
+	}
+
+	for name, test := range rules {
+		t.Run(name+" - irregular samples of sine", func(t *testing.T) {
+			// ∫[0,π] sin x dx = 2
+			res, err := numericalanalysis.IntegralPoints(samples(math.Sin, 0, math.Pi, 200, 1), test.rule)
+			if err != nil {
+				t.Fatalf("err = %v, want nil", err)
+			}
+			if math.Abs(res-2) > test.tol {
+				t.Errorf("result = %v, want ~2", res)
+			}
+		})
+
+		t.Run(name+" - exact for lines", func(t *testing.T) {
+			// ∫[1,4] 2x + 1 dx = 18
+			points := samples(func(x float64) float64 { return 2*x + 1 }, 1, 4, 7, 2)
+			res, err := numericalanalysis.IntegralPoints(points, test.rule)
+			if err != nil || math.Abs(res-18) > 1e-12 {
+				t.Errorf("result = %v, err = %v, want 18", res, err)
+			}
+		})
+
+		t.Run(name+" - cumulative integral", func(t *testing.T) {
+			points := samples(math.Cos, 0, 2, 101, 3)
+			cumulative, err := numericalanalysis.CumulativeIntegralPoints(points, test.rule)
+			if err != nil {
+				t.Fatalf("err = %v, want nil", err)
+			}
+			if len(cumulative) != len(points) || cumulative[0].X != 0 || cumulative[0].Y != 0 {
+				t.Fatalf("cumulative = %v, want running integral starting at (0, 0)", cumulative[:1])
+			}
+			for i, p := range cumulative {
+				if i > 0 && p.X <= cumulative[i-1].X {
+					t.Fatalf("cumulative[%d].X = %v, not sorted", i, p.X)
+				}
+				// ∫[0,x] cos = sin x
+				if math.Abs(p.Y-math.Sin(p.X)) > 10*test.tol {
+					t.Errorf("cumulative[%d] = %v, want ~%v", i, p, math.Sin(p.X))
+				}
+			}
+
+			total, _ := numericalanalysis.IntegralPoints(points, test.rule)
+			if math.Abs(cumulative[len(cumulative)-1].Y-total) > 1e-12 {
+				t.Errorf("last cumulative value = %v, want %v", cumulative[len(cumulative)-1].Y, total)
+			}
+		})
+	}
+
+	t.Run("simpson - exact for quadratics with odd number of intervals", func(t *testing.T) {
+		// ∫[0,3] x² dx = 9
+		points := samples(func(x float64) float64 { return x * x }, 0, 3, 6, 4)
+		res, err := numericalanalysis.IntegralPoints(points, numericalanalysis.TabulatedSimpson)
+		if err != nil || math.Abs(res-9) > 1e-12 {
+			t.Errorf("result = %v, err = %v, want 9", res, err)
+		}
+	})
+
+	t.Run("simpson - matches IntegralSimpson on a uniform grid", func(t *testing.T) {
+		var points []numericalanalysis.Point2D
+		for i := range 11 {
+			x := float64(i) / 10
+			points = append(points, numericalanalysis.Point2D{X: x, Y: math.Exp(x)})
+		}
+		res, _ := numericalanalysis.IntegralPoints(points, numericalanalysis.TabulatedSimpson)
+		expected, _ := numericalanalysis.IntegralSimpson(math.Exp, 0, 1, 10)
+		if math.Abs(res-expected) > 1e-14 {
+			t.Errorf("result = %v, want %v", res, expected)
+		}
+	})
+
+	t.Run("input does not get reordered", func(t *testing.T) {
+		points := []numericalanalysis.Point2D{{X: 2, Y: 1}, {X: 0, Y: 1}, {X: 1, Y: 1}}
+		if _, err := numericalanalysis.IntegralPoints(points, numericalanalysis.TabulatedSpline); err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if points[0].X != 2 {
+			t.Errorf("points = %v, input was modified", points)
+		}
+	})
+
+	t.Run("input validation", func(t *testing.T) {
+		if _, err := numericalanalysis.IntegralPoints([]numericalanalysis.Point2D{{X: 1, Y: 1}}, numericalanalysis.TabulatedTrapezoid); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("single point: err = %v, want ErrWrongInput", err)
+		}
+		duplicate := []numericalanalysis.Point2D{{X: 0, Y: 1}, {X: 1, Y: 1}, {X: 1, Y: 2}}
+		if _, err := numericalanalysis.CumulativeIntegralPoints(duplicate, numericalanalysis.TabulatedTrapezoid); err != numericalanalysis.ErrWrongInput {
+			t.Errorf("duplicate X: err = %v, want ErrWrongInput", err)
+		}
+	})
+}